@@ -0,0 +1,235 @@
+package valid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultAsyncWorkers bounds how many asynchronous checks run at the same time,
+// unless set with WithConcurrency
+const defaultAsyncWorkers = 4
+
+// AsyncRule is an I/O-bound check, e.g. a database lookup. It returns false
+// along with the message key and params of the error when the value is
+// invalid, and a non-nil error when the check itself could not be performed
+type AsyncRule[T any] func(ctx context.Context, value T) (MessageKey, MessageParams, bool, error)
+
+// asyncCheck is an asynchronous rule waiting to be run by Wait
+type asyncCheck struct {
+	v     *Validator
+	field string
+	run   func(ctx context.Context) (MessageKey, MessageParams, bool, error)
+}
+
+// asyncResult is the outcome of an asyncCheck
+type asyncResult struct {
+	key    MessageKey
+	params MessageParams
+	ok     bool
+	err    error
+}
+
+// WithContext returns a validator sharing the errors of v whose asynchronous
+// rules run with ctx
+func (v *Validator) WithContext(ctx context.Context) *Validator {
+	scoped := *v
+	scoped.ctx = ctx
+
+	return &scoped
+}
+
+// Context returns the context of the validator, which defaults to context.Background
+func (v *Validator) Context() context.Context {
+	if v.ctx == nil {
+		return context.Background()
+	}
+
+	return v.ctx
+}
+
+// enqueue registers an asynchronous check to be run by Wait
+func (v *Validator) enqueue(field string, run func(ctx context.Context) (MessageKey, MessageParams, bool, error)) {
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
+
+	v.shared.pending = append(v.shared.pending, asyncCheck{v: v, field: field, run: run})
+}
+
+// Wait runs the pending asynchronous rules concurrently, with at most a few of
+// them in flight at once, and adds their errors in the order the rules were
+// declared. Checks that could not be performed, including those cancelled
+// through the context, are reported in the returned error rather than as
+// validation errors.
+//
+// Errors and HasErrors only include asynchronous rules once Wait returns
+func (v *Validator) Wait() error {
+	v.shared.mu.Lock()
+	checks := v.shared.pending
+	v.shared.pending = nil
+	v.shared.mu.Unlock()
+
+	results := make([]asyncResult, len(checks))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(v.shared.workers, len(checks)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = checks[i].do()
+			}
+		}()
+	}
+
+	for i := range checks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var errs []error
+	for i, r := range results {
+		c := checks[i]
+		switch {
+		case r.err != nil:
+			errs = append(errs, fmt.Errorf("valid: %s: %w", joinPath(c.v.path, c.field), r.err))
+		case !r.ok:
+			c.v.AddError(c.field, r.key, r.params)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// do runs the check unless its context is already done
+func (c asyncCheck) do() asyncResult {
+	ctx := c.v.Context()
+	if err := ctx.Err(); err != nil {
+		return asyncResult{err: err}
+	}
+
+	key, params, ok, err := c.run(ctx)
+
+	return asyncResult{key: key, params: params, ok: ok, err: err}
+}
+
+// Async validates the string with an asynchronous rule run by Validator.Wait
+func (b *StringRuleBuilder) Async(fn AsyncRule[string]) *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		value := sv.value
+		sv.v.enqueue(sv.field, func(ctx context.Context) (MessageKey, MessageParams, bool, error) {
+			return fn(ctx, value)
+		})
+	})
+	return b
+}
+
+// Async validates the number with an asynchronous rule run by Validator.Wait
+func (b *NumberRuleBuilder[T]) Async(fn AsyncRule[T]) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		value := nv.value
+		nv.v.enqueue(nv.field, func(ctx context.Context) (MessageKey, MessageParams, bool, error) {
+			return fn(ctx, value)
+		})
+	})
+	return b
+}
+
+// Async validates the float with an asynchronous rule run by Validator.Wait
+func (b *Float64RuleBuilder[T]) Async(fn AsyncRule[T]) *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		value := fv.value
+		fv.v.enqueue(fv.field, func(ctx context.Context) (MessageKey, MessageParams, bool, error) {
+			return fn(ctx, value)
+		})
+	})
+
+	return b
+}
+
+// Async validates the time with an asynchronous rule run by Validator.Wait
+func (b *TimeRuleBuilder) Async(fn AsyncRule[time.Time]) *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		value := tv.value
+		tv.v.enqueue(tv.field, func(ctx context.Context) (MessageKey, MessageParams, bool, error) {
+			return fn(ctx, value)
+		})
+	})
+
+	return b
+}
+
+// Checker reports whether a value exists in a store, e.g. a database table
+type Checker interface {
+	Exists(ctx context.Context, value string) (bool, error)
+}
+
+// Unique validates that the string does not exist yet in the checker's store,
+// e.g. an email not already registered
+func (b *StringRuleBuilder) Unique(checker Checker) *StringRuleBuilder {
+	return b.Async(func(ctx context.Context, value string) (MessageKey, MessageParams, bool, error) {
+		exists, err := checker.Exists(ctx, value)
+		return MsgAlreadyExists, nil, !exists, err
+	})
+}
+
+// Exists validates that the string exists in the checker's store, e.g. a known SKU
+func (b *StringRuleBuilder) Exists(checker Checker) *StringRuleBuilder {
+	return b.Async(func(ctx context.Context, value string) (MessageKey, MessageParams, bool, error) {
+		exists, err := checker.Exists(ctx, value)
+		return MsgNotFound, nil, exists, err
+	})
+}
+
+// MemoryChecker is an in-memory Checker meant for tests
+type MemoryChecker struct {
+	// Delay simulates the latency of a real store. Exists honors the context
+	// while waiting
+	Delay time.Duration
+
+	mu     sync.RWMutex
+	values map[string]struct{}
+}
+
+// NewMemoryChecker creates a checker holding the given values
+func NewMemoryChecker(values ...string) *MemoryChecker {
+	c := &MemoryChecker{values: make(map[string]struct{}, len(values))}
+	c.Add(values...)
+
+	return c
+}
+
+// Add stores the given values
+func (c *MemoryChecker) Add(values ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, value := range values {
+		c.values[value] = struct{}{}
+	}
+}
+
+// Exists reports whether value was stored
+func (c *MemoryChecker) Exists(ctx context.Context, value string) (bool, error) {
+	if c.Delay > 0 {
+		timer := time.NewTimer(c.Delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.values[value]
+
+	return ok, nil
+}
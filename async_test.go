@@ -0,0 +1,140 @@
+package valid
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// taken is an async rule failing for every value but "free"
+func taken(ctx context.Context, value string) (MessageKey, MessageParams, bool, error) {
+	return MsgAlreadyExists, nil, value == "free", nil
+}
+
+func TestWaitDeclarationOrder(t *testing.T) {
+	v := New()
+
+	// The first checks sleep the longest, so they finish last
+	for i, field := range []string{"a", "b", "c", "d"} {
+		delay := time.Duration(4-i) * 10 * time.Millisecond
+		v.String(field, "taken", StringRules().Async(func(ctx context.Context, value string) (MessageKey, MessageParams, bool, error) {
+			time.Sleep(delay)
+			return taken(ctx, value)
+		}).Build()...)
+	}
+	v.Scope("user").String("email", "free", StringRules().Async(taken).Build()...)
+
+	if v.HasErrors() {
+		t.Fatal("async errors are reported before Wait")
+	}
+
+	if err := v.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertErrors(t, v, "a:already_exists", "b:already_exists", "c:already_exists", "d:already_exists")
+}
+
+func TestWaitContext(t *testing.T) {
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		v := New()
+		v.WithContext(ctx).String("email", "ana@example.com", StringRules().Unique(NewMemoryChecker()).Build()...)
+
+		err := v.Wait()
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Wait() = %v, want context.Canceled", err)
+		}
+
+		assertErrors(t, v)
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		checker := NewMemoryChecker("sku-1")
+		checker.Delay = time.Second
+
+		v := New().WithContext(ctx)
+		v.String("sku", "sku-2", StringRules().Exists(checker).Build()...)
+
+		err := v.Wait()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Wait() = %v, want context.DeadlineExceeded", err)
+		}
+
+		assertErrors(t, v)
+	})
+
+	t.Run("check errors", func(t *testing.T) {
+		failure := errors.New("connection refused")
+
+		v := New()
+		v.Scope("user").Int("id", 1, NumberRules[int64]().Async(func(context.Context, int64) (MessageKey, MessageParams, bool, error) {
+			return MsgNotFound, nil, false, failure
+		}).Build()...)
+		v.String("email", "taken", StringRules().Async(taken).Build()...)
+
+		err := v.Wait()
+		if !errors.Is(err, failure) || err.Error() != "valid: user.id: connection refused" {
+			t.Errorf("Wait() = %v, want the check error", err)
+		}
+
+		assertErrors(t, v, "email:already_exists")
+	})
+}
+
+func TestWaitConcurrency(t *testing.T) {
+	for _, workers := range []int{1, 3} {
+		var running, peak atomic.Int32
+		check := func(ctx context.Context, value float64) (MessageKey, MessageParams, bool, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			return "", nil, true, nil
+		}
+
+		v := New(WithConcurrency(workers))
+		for i := 0; i < 12; i++ {
+			v.Float64("price", float64(i), FloatRules[float64]().Async(check).Build()...)
+		}
+
+		if err := v.Wait(); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := peak.Load(); got > int32(workers) {
+			t.Errorf("WithConcurrency(%d): %d checks ran at once", workers, got)
+		}
+	}
+}
+
+func TestMemoryChecker(t *testing.T) {
+	checker := NewMemoryChecker("ana@example.com")
+
+	v := New()
+	v.String("email", "ana@example.com", StringRules().Unique(checker).Build()...)
+	v.String("referrer", "bob@example.com", StringRules().Exists(checker).Build()...)
+	v.Time("at", time.Now(), TimeRules().Async(func(context.Context, time.Time) (MessageKey, MessageParams, bool, error) {
+		return MsgFuture, nil, false, nil
+	}).Build()...)
+
+	if err := v.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertErrors(t, v, "email:already_exists", "referrer:not_found", "at:future")
+}
@@ -0,0 +1,93 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Catalog holds message templates per locale. It overrides built-in messages
+// or adds the keys of custom rules
+type Catalog map[Locale]map[MessageKey]string
+
+// LoadCatalog reads the per-locale JSON files found in dir, named after their
+// locale (es.json, es-PE.json, en.json), so they can be embedded with go:embed:
+//
+//	//go:embed messages/*.json
+//	var messages embed.FS
+//
+//	catalog, err := valid.LoadCatalog(messages, "messages")
+//
+// Each file holds an object of message keys to templates, e.g.
+// {"required": "es obligatorio"}. Templates are checked while loading
+func LoadCatalog(fsys fs.FS, dir string) (Catalog, error) {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	c := make(Catalog, len(files))
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		var msgs map[MessageKey]string
+		if err := json.Unmarshal(data, &msgs); err != nil {
+			return nil, fmt.Errorf("valid: %s: %w", file, err)
+		}
+
+		locale := ParseLocale(strings.TrimSuffix(path.Base(file), ".json"))
+		c.Merge(Catalog{locale: msgs})
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Merge copies the messages of other into c, replacing the ones with the same
+// locale and key
+func (c Catalog) Merge(other Catalog) {
+	for locale, msgs := range other {
+		if c[locale] == nil {
+			c[locale] = make(map[MessageKey]string, len(msgs))
+		}
+
+		for key, msg := range msgs {
+			c[locale][key] = msg
+		}
+	}
+}
+
+// Locales returns the locales of the catalog in order, e.g. to negotiate them
+// with LocaleMiddleware
+func (c Catalog) Locales() []Locale {
+	locales := make([]Locale, 0, len(c))
+	for locale := range c {
+		locales = append(locales, locale)
+	}
+	sort.Slice(locales, func(i, j int) bool { return locales[i] < locales[j] })
+
+	return locales
+}
+
+// Validate checks that every template of the catalog can be parsed, and that
+// the templates of built-in keys only use the params those keys provide
+func (c Catalog) Validate() error {
+	for locale, msgs := range c {
+		for key, msg := range msgs {
+			if _, err := parseMessage(key, msg); err != nil {
+				return fmt.Errorf("valid: %s: %s: %w", locale, key, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadCatalog(t *testing.T) {
+	fsys := fstest.MapFS{
+		"messages/es.json":    {Data: []byte(`{"required": "es obligatorio", "slug": "no es un slug válido"}`)},
+		"messages/es-PE.json": {Data: []byte(`{"required": "es obligatorio, causa"}`)},
+		"messages/notes.txt":  {Data: []byte(`ignored`)},
+	}
+
+	catalog, err := LoadCatalog(fsys, "messages")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := catalog[Locale("es-PE")][MsgRequired]; got != "es obligatorio, causa" {
+		t.Errorf("es-PE required = %q", got)
+	}
+
+	translator := NewTranslator(catalog)
+	tests := []struct {
+		locale Locale
+		key    MessageKey
+		want   string
+	}{
+		{LocaleES, MsgRequired, "es obligatorio"},
+		{LocaleES, "slug", "no es un slug válido"},
+		{Locale("es-PE"), MsgRequired, "es obligatorio, causa"},
+		{Locale("es-PE"), "slug", "no es un slug válido"},
+		{LocaleEN, MsgMinLength, "minimum length is 3 characters"},
+	}
+
+	for _, tt := range tests {
+		got := translator.Translate(tt.locale, tt.key, MessageParams{"min": 3})
+		if !strings.Contains(got, tt.want) {
+			t.Errorf("Translate(%s, %s) = %q, want it to contain %q", tt.locale, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestLoadCatalogErrors(t *testing.T) {
+	tests := map[string]string{
+		"invalid json":     `{"required": `,
+		"invalid template": `{"required": "{"}`,
+		"unknown param":    `{"min_length": "at least {max}"}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			fsys := fstest.MapFS{"messages/en.json": {Data: []byte(data)}}
+			if _, err := LoadCatalog(fsys, "messages"); err == nil {
+				t.Error("LoadCatalog succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestCatalogMerge(t *testing.T) {
+	c := Catalog{LocaleEN: {MsgRequired: "required", MsgEmail: "email"}}
+	c.Merge(Catalog{LocaleEN: {MsgRequired: "is required"}, LocaleFR: {MsgRequired: "obligatoire"}})
+
+	if c[LocaleEN][MsgRequired] != "is required" || c[LocaleEN][MsgEmail] != "email" || c[LocaleFR][MsgRequired] != "obligatoire" {
+		t.Errorf("Merge() = %v", c)
+	}
+}
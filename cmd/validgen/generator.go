@@ -0,0 +1,938 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	tagName    = "valid"
+	commentTag = "valid:"
+	validPkg   = "github.com/techforge-lat/valid"
+)
+
+// rule is a single annotation rule, e.g. max=120
+type rule struct {
+	name  string
+	param string
+}
+
+// field is an annotated struct field, or an untagged embedded struct whose
+// annotated fields are promoted
+type field struct {
+	goName   string
+	name     string
+	typ      types.Type
+	rules    []rule
+	promoted bool
+	pos      token.Position
+}
+
+// structType is a struct with at least one annotated field
+type structType struct {
+	name   string
+	fields []field
+}
+
+// generator writes the source of the generated file
+type generator struct {
+	buf     bytes.Buffer
+	pkg     *types.Package
+	imports map[string]bool
+}
+
+// generate loads the package in dir and returns the formatted source of the
+// generated file
+func generate(dir, output string, only []string) ([]byte, error) {
+	pkg, err := loadPackage(dir, output)
+	if err != nil {
+		return nil, err
+	}
+
+	structs, err := annotatedStructs(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(only) > 0 {
+		structs = slices.DeleteFunc(structs, func(s structType) bool {
+			return !slices.Contains(only, s.name)
+		})
+	}
+
+	if len(structs) == 0 {
+		return nil, fmt.Errorf("no annotated structs found in %s", dir)
+	}
+
+	body := &generator{pkg: pkg.Types, imports: map[string]bool{validPkg: true}}
+	for _, s := range structs {
+		if err := body.structType(s); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by validgen. DO NOT EDIT.\n\npackage %s\n\n", pkg.Name)
+
+	// Standard library imports go first, separated from the rest as goimports does
+	var std, others []string
+	for imp := range body.imports {
+		if first, _, _ := strings.Cut(imp, "/"); strings.Contains(first, ".") {
+			others = append(others, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+
+	out.WriteString("import (\n")
+	for i, group := range [][]string{std, others} {
+		if i > 0 && len(std) > 0 {
+			out.WriteString("\n")
+		}
+		for _, imp := range group {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+	}
+	out.WriteString(")\n\n")
+	out.Write(body.buf.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+// loadPackage parses and type-checks the non-test files of the package in dir,
+// so field types are resolved to their underlying types as Validator.Struct
+// does through reflection
+func loadPackage(dir, output string) (*packages.Package, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	outputPath := filepath.Join(absDir, output)
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedTypesInfo,
+		Dir:  absDir,
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			// A previously generated file may be stale, so only its package
+			// clause is kept
+			if filename == outputPath {
+				return parser.ParseFile(fset, filename, src, parser.PackageClauseOnly)
+			}
+
+			return parser.ParseFile(fset, filename, src, parser.ParseComments)
+		},
+	}
+
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package in %s, found %d", dir, len(pkgs))
+	}
+
+	// Type errors are tolerated, as the package may call the methods being
+	// generated; fields whose type cannot be resolved are reported later
+	pkg := pkgs[0]
+	for _, err := range pkg.Errors {
+		if err.Kind != packages.TypeError {
+			return nil, err
+		}
+	}
+
+	return pkg, nil
+}
+
+// annotatedStructs collects the structs of pkg with annotated fields
+func annotatedStructs(pkg *packages.Package) ([]structType, error) {
+	type decl struct {
+		name string
+		st   *ast.StructType
+	}
+
+	var decls []decl
+	for _, file := range pkg.Syntax {
+		for _, d := range file.Decls {
+			gen, ok := d.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if st, ok := ts.Type.(*ast.StructType); ok && ts.TypeParams == nil {
+					decls = append(decls, decl{name: ts.Name.Name, st: st})
+				}
+			}
+		}
+	}
+
+	// Structs with annotated fields get a ValidateWith method, which embedding
+	// structs call for their promoted fields
+	generated := make(map[string]bool)
+	for _, d := range decls {
+		for _, f := range d.st.Fields.List {
+			if annotation, ok := fieldAnnotation(f); ok && annotation != "-" {
+				generated[d.name] = true
+			}
+		}
+	}
+
+	var structs []structType
+	for _, d := range decls {
+		s, err := annotatedStruct(pkg, generated, d.name, d.st)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(s.fields) > 0 {
+			structs = append(structs, s)
+		}
+	}
+
+	return structs, nil
+}
+
+// annotatedStruct collects the annotated fields of a struct
+func annotatedStruct(pkg *packages.Package, generated map[string]bool, name string, st *ast.StructType) (structType, error) {
+	s := structType{name: name}
+	for _, f := range st.Fields.List {
+		typ := pkg.TypesInfo.TypeOf(f.Type)
+		if typ == nil || typ == types.Typ[types.Invalid] {
+			pos := pkg.Fset.Position(f.Pos())
+			return s, fmt.Errorf("%s: %s: cannot resolve the type of the field", pos, name)
+		}
+
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			if raw, err := strconv.Unquote(f.Tag.Value); err == nil {
+				tag = reflect.StructTag(raw)
+			}
+		}
+
+		annotation, ok := fieldAnnotation(f)
+		if annotation == "-" {
+			continue
+		}
+
+		if len(f.Names) == 0 {
+			goName := embeddedName(typ)
+			if !token.IsExported(goName) {
+				continue
+			}
+
+			// As in Validator.Struct, untagged embedded structs promote their fields
+			if !ok {
+				if jsonName(tag, "") == "" && validates(typ, generated, pkg.Types) {
+					s.fields = append(s.fields, field{
+						goName:   goName,
+						typ:      typ,
+						promoted: true,
+						pos:      pkg.Fset.Position(f.Pos()),
+					})
+				}
+				continue
+			}
+
+			s.fields = append(s.fields, field{
+				goName: goName,
+				name:   jsonName(tag, goName),
+				typ:    typ,
+				rules:  parseRules(annotation),
+				pos:    pkg.Fset.Position(f.Pos()),
+			})
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		for _, ident := range f.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			s.fields = append(s.fields, field{
+				goName: ident.Name,
+				name:   jsonName(tag, ident.Name),
+				typ:    typ,
+				rules:  parseRules(annotation),
+				pos:    pkg.Fset.Position(ident.Pos()),
+			})
+		}
+	}
+
+	return s, nil
+}
+
+// embeddedName returns the field name of an embedded type, i.e. its type name
+func embeddedName(typ types.Type) string {
+	if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	if named, ok := types.Unalias(typ).(*types.Named); ok {
+		return named.Obj().Name()
+	}
+
+	return ""
+}
+
+// validates reports whether validating a value of type typ can report errors:
+// it is a struct of the package with annotated fields, implements
+// valid.Validatable or has tagged fields, including promoted ones
+func validates(typ types.Type, generated map[string]bool, pkg *types.Package) bool {
+	if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || isTime(named) {
+		return false
+	}
+
+	if named.Obj().Pkg() == pkg && generated[named.Obj().Name()] {
+		return true
+	}
+
+	if sel := types.NewMethodSet(types.NewPointer(named)).Lookup(nil, "ValidateWith"); sel != nil {
+		return true
+	}
+
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		if annotation, ok := tag.Lookup(tagName); ok && annotation != "-" {
+			return true
+		}
+
+		if f.Embedded() && f.Exported() && jsonName(tag, "") == "" && validates(f.Type(), generated, pkg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isTime reports whether typ is time.Time
+func isTime(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time"
+}
+
+// fieldAnnotation returns the rules of a field from its tag or its comments
+func fieldAnnotation(f *ast.Field) (string, bool) {
+	if f.Tag != nil {
+		if raw, err := strconv.Unquote(f.Tag.Value); err == nil {
+			if annotation, ok := reflect.StructTag(raw).Lookup(tagName); ok {
+				return annotation, true
+			}
+		}
+	}
+
+	for _, group := range []*ast.CommentGroup{f.Doc, f.Comment} {
+		if group == nil {
+			continue
+		}
+
+		for _, c := range group.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if annotation, ok := strings.CutPrefix(text, commentTag); ok {
+				return strings.TrimSpace(annotation), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// jsonName returns the json name of a field, or goName when it has none
+func jsonName(tag reflect.StructTag, goName string) string {
+	name, _, _ := strings.Cut(tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return goName
+	}
+
+	return name
+}
+
+// parseRules splits an annotation into its rules
+func parseRules(annotation string) []rule {
+	var rules []rule
+	for _, part := range strings.Split(annotation, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, param, _ := strings.Cut(part, "=")
+		rules = append(rules, rule{name: name, param: param})
+	}
+
+	return rules
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// structType writes the ValidateWith and Validate methods of a struct
+func (g *generator) structType(s structType) error {
+	recv := strings.ToLower(s.name[:1])
+	if recv == "v" {
+		// v holds the validator in the generated methods
+		recv = "s"
+	}
+
+	g.printf("// ValidateWith validates %s using its valid annotations\n", s.name)
+	g.printf("func (%s *%s) ValidateWith(v *valid.Validator) {\n", recv, s.name)
+
+	for _, f := range s.fields {
+		if err := g.field(recv, f); err != nil {
+			return fmt.Errorf("%s: %s.%s: %w", f.pos, s.name, f.goName, err)
+		}
+	}
+
+	// Every field leaves a blank line behind, which is not wanted before the brace
+	g.buf.Truncate(g.buf.Len() - 1)
+	g.printf("}\n\n")
+
+	g.printf("// Validate validates %s and returns its validation errors, if any\n", s.name)
+	g.printf("func (%s *%s) Validate() error {\n", recv, s.name)
+	g.printf("\tv := valid.New()\n\t%s.ValidateWith(v)\n\n", recv)
+	g.printf("\tif v.HasErrors() {\n\t\treturn v.Errors()\n\t}\n\n\treturn nil\n}\n\n")
+
+	return nil
+}
+
+// field writes the validation of a single field. Types are matched by their
+// underlying type, so named types such as type Role string are validated as
+// their underlying type, converted where the validators need it
+func (g *generator) field(recv string, f field) error {
+	expr := recv + "." + f.goName
+
+	typ := types.Unalias(f.typ)
+	elem, isPtr := typ, false
+	if ptr, ok := typ.(*types.Pointer); ok {
+		elem, isPtr = types.Unalias(ptr.Elem()), true
+	}
+
+	if f.promoted {
+		if !isPtr {
+			expr = "&" + expr
+		}
+		g.printf("\tv.Nested(\"\", %s)\n\n", expr)
+		return nil
+	}
+
+	rules, dive := cutRule(f.rules, "dive")
+	if dive {
+		switch typ.Underlying().(type) {
+		case *types.Slice, *types.Array, *types.Map:
+		default:
+			return fmt.Errorf("rule \"dive\" is not supported on %s fields", g.typeName(typ))
+		}
+	}
+
+	if isTime(elem) {
+		calls, err := g.timeCalls(rules)
+		if err != nil {
+			return err
+		}
+
+		method := "Time"
+		if isPtr {
+			method = "TimePtr"
+		}
+		g.options(fmt.Sprintf("v.%s(%q, %s, valid.TimeRules()", method, f.name, expr), calls)
+
+		return nil
+	}
+
+	switch u := elem.Underlying().(type) {
+	case *types.Basic:
+		if err := g.basic(f, rules, expr, elem, u, isPtr); err != nil {
+			return err
+		}
+	case *types.Slice, *types.Array:
+		if isPtr && !dive {
+			return g.slicePtr(f, rules, expr, elem)
+		}
+
+		if isPtr {
+			return fmt.Errorf("rule \"dive\" is not supported on %s fields", g.typeName(typ))
+		}
+
+		calls, err := sliceCalls(rules)
+		if err != nil {
+			return err
+		}
+		g.chain(g.sliceCall(f.name, expr, elem), calls)
+	case *types.Map:
+		if !dive {
+			return fmt.Errorf("map fields need the \"dive\" rule")
+		}
+
+		if len(rules) > 0 {
+			return fmt.Errorf("rule %q is not supported on map fields", rules[0].name)
+		}
+	case *types.Struct:
+		// Structs are validated through their own ValidateWith method or their
+		// tags. Pointers can be required to be present
+		for _, r := range rules {
+			switch {
+			case !isPtr || !slices.Contains([]string{"required", "notnil", "optional", "nullable"}, r.name):
+				return fmt.Errorf("rule %q is not supported on %s fields", r.name, g.typeName(typ))
+			case r.name == "required" || r.name == "notnil":
+				key := map[string]string{"required": "MsgRequired", "notnil": "MsgNotNil"}[r.name]
+				g.printf("\tif %s == nil {\n\t\tv.AddError(%q, valid.%s, nil)\n\t}\n", expr, f.name, key)
+			}
+		}
+
+		value := expr
+		if !isPtr {
+			value = "&" + value
+		}
+		g.printf("\tv.Nested(%q, %s)\n\n", f.name, value)
+	default:
+		return fmt.Errorf("unsupported type %s", g.typeName(typ))
+	}
+
+	if dive {
+		g.printf("\tv.Dive(%q, %s)\n\n", f.name, expr)
+	}
+
+	return nil
+}
+
+// basic writes the validation of a string, integer or float field, or of a
+// pointer to one
+func (g *generator) basic(f field, rules []rule, expr string, elem types.Type, u *types.Basic, isPtr bool) error {
+	// Named types are converted to the type of the validator, e.g. string(s.Role)
+	// or (*string)(s.Role) for pointers
+	convert := func(basic string) string {
+		if types.Identical(elem, u) {
+			return expr
+		}
+
+		if isPtr {
+			return fmt.Sprintf("(*%s)(%s)", basic, expr)
+		}
+
+		return fmt.Sprintf("%s(%s)", basic, expr)
+	}
+
+	info := u.Info()
+	switch {
+	case info&types.IsString != 0:
+		calls, err := stringCalls(rules)
+		if err != nil {
+			return err
+		}
+
+		method := "String"
+		if isPtr {
+			method = "StringPtr"
+		}
+		g.options(fmt.Sprintf("v.%s(%q, %s, valid.StringRules()", method, f.name, convert("string")), calls)
+	case info&types.IsInteger != 0:
+		unsigned := info&types.IsUnsigned != 0
+		bits := 64
+		if size := types.SizesFor("gc", "amd64").Sizeof(u); size > 0 {
+			bits = int(size) * 8
+		}
+
+		parse := func(s string) error {
+			if unsigned {
+				_, err := strconv.ParseUint(s, 10, bits)
+				return err
+			}
+
+			_, err := strconv.ParseInt(s, 10, bits)
+			return err
+		}
+
+		calls, err := numberCalls(rules, parse)
+		if err != nil {
+			return err
+		}
+
+		var call string
+		switch {
+		case !isPtr && !unsigned:
+			value := expr
+			if u.Kind() != types.Int64 || !types.Identical(elem, u) {
+				value = "int64(" + expr + ")"
+			}
+			call = fmt.Sprintf("v.Int(%q, %s, valid.NumberRules[int64]()", f.name, value)
+		case !isPtr:
+			value := expr
+			if u.Kind() != types.Uint || !types.Identical(elem, u) {
+				value = "uint(" + expr + ")"
+			}
+			call = fmt.Sprintf("v.Uint(%q, %s, valid.NumberRules[uint]()", f.name, value)
+		case u.Kind() == types.Int64:
+			call = fmt.Sprintf("v.IntPtr(%q, %s, valid.NumberRules[int64]()", f.name, convert("int64"))
+		case u.Kind() == types.Uint:
+			call = fmt.Sprintf("v.UintPtr(%q, %s, valid.NumberRules[uint]()", f.name, convert("uint"))
+		default:
+			call = fmt.Sprintf("valid.NumberPtr(v, %q, %s, valid.NumberRules[%s]()", f.name, convert(u.Name()), u.Name())
+		}
+		g.options(call, calls)
+	case info&types.IsFloat != 0:
+		calls, err := floatCalls(rules)
+		if err != nil {
+			return err
+		}
+
+		method := map[types.BasicKind]string{types.Float32: "Float32", types.Float64: "Float64"}[u.Kind()]
+		if isPtr {
+			method += "Ptr"
+		}
+		g.options(fmt.Sprintf("v.%s(%q, %s, valid.FloatRules[%s]()", method, f.name, convert(u.Name()), u.Name()), calls)
+	default:
+		return fmt.Errorf("unsupported type %s", g.typeName(f.typ))
+	}
+
+	return nil
+}
+
+// sliceCall returns the validator call of a slice or array expression
+func (g *generator) sliceCall(name, expr string, typ types.Type) string {
+	for method, elem := range map[string]types.BasicKind{"StringSlice": types.String, "Int64Slice": types.Int64, "Float64Slice": types.Float64} {
+		if types.Identical(typ, types.NewSlice(types.Typ[elem])) {
+			return fmt.Sprintf("v.%s(%q, %s)", method, name, expr)
+		}
+	}
+
+	if _, ok := typ.Underlying().(*types.Array); ok {
+		// Arrays are sliced to fit NewSliceValidator
+		expr += "[:]"
+	}
+
+	return fmt.Sprintf("valid.NewSliceValidator(v, %q, %s)", name, expr)
+}
+
+// slicePtr writes the validation of a pointer to a slice or array, validated as
+// an empty slice when nil
+func (g *generator) slicePtr(f field, rules []rule, expr string, elem types.Type) error {
+	calls, err := sliceCalls(rules)
+	if err != nil {
+		return err
+	}
+
+	if len(calls) == 0 {
+		return nil
+	}
+
+	sliceType, value := elem, "*"+expr
+	if array, ok := elem.Underlying().(*types.Array); ok {
+		sliceType, value = types.NewSlice(array.Elem()), expr+"[:]"
+	}
+
+	g.printf("\t{\n\t\tvar value %s\n", g.typeName(sliceType))
+	g.printf("\t\tif %s != nil {\n\t\t\tvalue = %s\n\t\t}\n", expr, value)
+	g.chain(g.sliceCall(f.name, "value", sliceType), calls)
+	g.buf.Truncate(g.buf.Len() - 1)
+	g.printf("\t}\n\n")
+
+	return nil
+}
+
+// typeName renders a type, importing the packages it refers to
+func (g *generator) typeName(typ types.Type) string {
+	return types.TypeString(typ, func(p *types.Package) string {
+		if p == g.pkg {
+			return ""
+		}
+
+		g.imports[p.Path()] = true
+
+		return p.Name()
+	})
+}
+
+// options writes a validator call taking the rules of a builder
+func (g *generator) options(call string, calls []string) {
+	if len(calls) == 0 {
+		return
+	}
+
+	g.printf("\t%s.\n", call)
+	for _, c := range calls {
+		g.printf("\t\t%s.\n", c)
+	}
+	g.printf("\t\tBuild()...)\n\n")
+}
+
+// chain writes a validator call followed by chained rules
+func (g *generator) chain(call string, calls []string) {
+	if len(calls) == 0 {
+		return
+	}
+
+	g.printf("\t%s", call)
+	for _, c := range calls {
+		g.printf(".\n\t\t%s", c)
+	}
+	g.printf("\n\n")
+}
+
+// stringCalls maps rules onto StringRuleBuilder methods
+func stringCalls(rules []rule) ([]string, error) {
+	calls := make([]string, 0, len(rules))
+	for _, r := range rules {
+		switch r.name {
+		case "rule":
+			calls = append(calls, ruleCalls(r)...)
+		case "optional", "nullable", "notnil":
+			calls = append(calls, nilCall(r))
+		case "required":
+			calls = append(calls, "Required()")
+		case "email":
+			calls = append(calls, "Email()")
+		case "uuid":
+			calls = append(calls, "UUID()")
+		case "oneof":
+			values := strings.Fields(r.param)
+			for i, value := range values {
+				values[i] = strconv.Quote(value)
+			}
+			calls = append(calls, "OneOf("+strings.Join(values, ", ")+")")
+		case "min", "max":
+			if err := checkInt(r); err != nil {
+				return nil, err
+			}
+
+			method := map[string]string{"min": "MinLength", "max": "MaxLength"}[r.name]
+			calls = append(calls, fmt.Sprintf("%s(%s)", method, r.param))
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return calls, nil
+}
+
+// numberCalls maps rules onto NumberRuleBuilder methods
+func numberCalls(rules []rule, parse func(string) error) ([]string, error) {
+	calls := make([]string, 0, len(rules))
+	for _, r := range rules {
+		switch r.name {
+		case "rule":
+			calls = append(calls, ruleCalls(r)...)
+		case "optional", "nullable", "notnil":
+			calls = append(calls, nilCall(r))
+		case "required":
+			calls = append(calls, "Required()")
+		case "min", "max":
+			if err := parse(r.param); err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			method := map[string]string{"min": "Min", "max": "Max"}[r.name]
+			calls = append(calls, fmt.Sprintf("%s(%s)", method, r.param))
+		case "between":
+			bounds, err := params(r, 2, parse)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, fmt.Sprintf("Between(%s, %s)", bounds[0], bounds[1]))
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return calls, nil
+}
+
+// floatCalls maps rules onto Float64RuleBuilder methods
+func floatCalls(rules []rule) ([]string, error) {
+	parse := func(s string) error {
+		_, err := strconv.ParseFloat(s, 64)
+		return err
+	}
+
+	calls := make([]string, 0, len(rules))
+	for _, r := range rules {
+		if r.name != "precision" {
+			call, err := numberCalls([]rule{r}, parse)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, call...)
+			continue
+		}
+
+		if err := checkInt(r); err != nil {
+			return nil, err
+		}
+		calls = append(calls, fmt.Sprintf("Precision(%s)", r.param))
+	}
+
+	return calls, nil
+}
+
+// timeCalls maps rules onto TimeRuleBuilder methods
+func (g *generator) timeCalls(rules []rule) ([]string, error) {
+	calls := make([]string, 0, len(rules))
+	for _, r := range rules {
+		switch r.name {
+		case "rule":
+			calls = append(calls, ruleCalls(r)...)
+		case "optional", "nullable", "notnil":
+			calls = append(calls, nilCall(r))
+		case "required":
+			calls = append(calls, "Required()")
+		case "past":
+			calls = append(calls, "Past()")
+		case "future":
+			calls = append(calls, "Future()")
+		case "after", "before":
+			t, err := time.Parse(time.RFC3339, r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			g.imports["time"] = true
+			method := map[string]string{"after": "After", "before": "Before"}[r.name]
+			calls = append(calls, fmt.Sprintf("%s(%s)", method, timeLiteral(t)))
+		case "minage", "maxage":
+			if err := checkInt(r); err != nil {
+				return nil, err
+			}
+
+			method := map[string]string{"minage": "MinAge", "maxage": "MaxAge"}[r.name]
+			calls = append(calls, fmt.Sprintf("%s(%s)", method, r.param))
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return calls, nil
+}
+
+// sliceCalls maps rules onto SliceValidator methods
+func sliceCalls(rules []rule) ([]string, error) {
+	calls := make([]string, 0, len(rules))
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			calls = append(calls, "Required()")
+		case "min", "max", "len":
+			if err := checkInt(r); err != nil {
+				return nil, err
+			}
+
+			method := map[string]string{"min": "MinLength", "max": "MaxLength", "len": "Length"}[r.name]
+			calls = append(calls, fmt.Sprintf("%s(%s)", method, r.param))
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return calls, nil
+}
+
+// ruleCalls maps a rule=name annotation onto calls to the registered rules it names
+func ruleCalls(r rule) []string {
+	var calls []string
+	for _, name := range strings.Fields(r.param) {
+		calls = append(calls, fmt.Sprintf("Rule(%q)", name))
+	}
+
+	return calls
+}
+
+// nilCall maps the rules dealing with absent values onto their builder method
+func nilCall(r rule) string {
+	return map[string]string{"optional": "Optional()", "nullable": "Nullable()", "notnil": "NotNil()"}[r.name]
+}
+
+// timeLiteral renders t as a time.Date call
+func timeLiteral(t time.Time) string {
+	loc := "time.UTC"
+	if _, offset := t.Zone(); offset != 0 {
+		loc = fmt.Sprintf("time.FixedZone(\"\", %d)", offset)
+	}
+
+	return fmt.Sprintf("time.Date(%d, %d, %d, %d, %d, %d, %d, %s)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// cutRule removes the rule with the given name, reporting whether it was present
+func cutRule(rules []rule, name string) ([]rule, bool) {
+	for i, r := range rules {
+		if r.name == name {
+			return append(rules[:i:i], rules[i+1:]...), true
+		}
+	}
+
+	return rules, false
+}
+
+// params splits and checks the space separated arguments of a rule
+func params(r rule, n int, parse func(string) error) ([]string, error) {
+	fields := strings.Fields(r.param)
+	if len(fields) != n {
+		return nil, fmt.Errorf("rule %q expects %d arguments, got %d", r.name, n, len(fields))
+	}
+
+	for _, field := range fields {
+		if err := parse(field); err != nil {
+			return nil, invalidParam(r, err)
+		}
+	}
+
+	return fields, nil
+}
+
+func checkInt(r rule) error {
+	if _, err := strconv.Atoi(r.param); err != nil {
+		return invalidParam(r, err)
+	}
+
+	return nil
+}
+
+func invalidParam(r rule, err error) error {
+	return fmt.Errorf("invalid argument %q for rule %q: %w", r.param, r.name, err)
+}
+
+func unknownRule(r rule) error {
+	return fmt.Errorf("unknown rule %q", r.name)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	// The generated code type-checked by the tests imports the library
+	_ "github.com/techforge-lat/valid"
+	"golang.org/x/tools/go/packages"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	dir := filepath.Join("testdata", "models")
+	src, err := generate(dir, "valid_gen.go", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join(dir, "valid_gen.go.golden")
+	if *update {
+		if err := os.WriteFile(golden, src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(src, want) {
+		t.Errorf("generated code differs from %s, run go test -update\n%s", golden, src)
+	}
+
+	// The generated file must compile along with the package
+	abs, err := filepath.Abs(filepath.Join(dir, "valid_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:    packages.NeedName | packages.NeedTypes,
+		Dir:     dir,
+		Overlay: map[string][]byte{abs: src},
+	}, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	packages.Visit(pkgs, nil, func(p *packages.Package) {
+		for _, err := range p.Errors {
+			t.Errorf("generated code does not compile: %v", err)
+		}
+	})
+}
+
+func TestGenerateOnly(t *testing.T) {
+	src, err := generate(filepath.Join("testdata", "models"), "valid_gen.go", []string{"Address"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Contains(src, []byte("func (a *Address) ValidateWith")) {
+		t.Errorf("Address is not generated:\n%s", src)
+	}
+
+	if bytes.Contains(src, []byte("CreateUser")) {
+		t.Errorf("CreateUser is generated although -type excludes it:\n%s", src)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "unknown rule",
+			src:  "type T struct {\n\tName string `valid:\"unknown\"`\n}",
+			want: `unknown rule "unknown"`,
+		},
+		{
+			name: "invalid parameter",
+			src:  "type T struct {\n\tAge int `valid:\"min=abc\"`\n}",
+			want: "min",
+		},
+		{
+			name: "parameter out of range",
+			src:  "type T struct {\n\tAge int8 `valid:\"max=300\"`\n}",
+			want: "max",
+		},
+		{
+			name: "map without dive",
+			src:  "type T struct {\n\tM map[string]int `valid:\"required\"`\n}",
+			want: `map fields need the "dive" rule`,
+		},
+		{
+			name: "presence rule on struct value",
+			src:  "type A struct {\n\tS string `valid:\"required\"`\n}\n\ntype T struct {\n\tA A `valid:\"required\"`\n}",
+			want: `rule "required" is not supported on A fields`,
+		},
+		{
+			name: "unsupported type",
+			src:  "type T struct {\n\tC chan int `valid:\"required\"`\n}",
+			want: "unsupported type chan int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "go.mod"), "module example.com/p\n\ngo 1.22\n")
+			writeFile(t, filepath.Join(dir, "p.go"), "package p\n\n"+tt.src+"\n")
+
+			_, err := generate(dir, "valid_gen.go", nil)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,18 @@
+module github.com/techforge-lat/valid/cmd/validgen
+
+go 1.22.6
+
+require (
+	github.com/techforge-lat/valid v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.30.0
+)
+
+require (
+	github.com/google/uuid v1.6.0 // indirect
+	golang.org/x/exp v0.0.0-20241009180824-f66d83c29e7c // indirect
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+)
+
+// The generator is developed along with the library
+replace github.com/techforge-lat/valid => ../..
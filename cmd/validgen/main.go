@@ -0,0 +1,60 @@
+// Command validgen generates Validate methods from the `valid` annotations of
+// the structs in a package.
+//
+// It is meant to be run through go generate:
+//
+//	//go:generate go run github.com/techforge-lat/valid/cmd/validgen
+//
+// It lives in a module of its own, so users of the library do not depend on
+// the packages the generator needs to type-check code.
+//
+// Fields are annotated either with a struct tag or with a comment, using the
+// same rules understood by valid.Validator.Struct:
+//
+//	type CreateUser struct {
+//		Email string `json:"email" valid:"required,email,max=120"`
+//		Age   int64  `json:"age"` // valid:between=18 130
+//	}
+//
+// Every annotated struct gets a ValidateWith(*valid.Validator) method, which
+// makes it a valid.Validatable, and a Validate() error method wrapping it. They
+// call the matching validators and rule builders, so no reflection happens at
+// runtime and a misspelled rule fails at generation time. The package is
+// type-checked, so named types are validated as their underlying type, and
+// pointers use the pointer-aware validators such as Validator.StringPtr or
+// valid.NumberPtr. Struct fields are validated through Validator.Nested,
+// untagged embedded structs promote their fields, and the dive rule validates
+// every element of a slice or map through Validator.Dive.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package to generate validators for")
+	output := flag.String("output", "valid_gen.go", "name of the generated file, relative to -dir")
+	types := flag.String("type", "", "comma separated list of types to generate; defaults to every annotated struct")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("validgen: ")
+
+	var only []string
+	if *types != "" {
+		only = strings.Split(*types, ",")
+	}
+
+	src, err := generate(*dir, *output, only)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(*dir, *output), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
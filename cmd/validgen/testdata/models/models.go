@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+type Role string
+
+type Level int32
+
+type Base struct {
+	ID string `json:"id" valid:"required"`
+}
+
+type Address struct {
+	Street string `json:"street" valid:"required"`
+}
+
+type CreateUser struct {
+	Email string    `json:"email" valid:"required,email,max=120"`
+	Age   int       `json:"age"` // valid:between=18 130
+	Score float32   `json:"score" valid:"between=0 100,precision=2"`
+	Tags  []string  `json:"tags" valid:"required,max=5"`
+	IDs   [3]int    `json:"ids" valid:"len=3"`
+	Birth time.Time `json:"birth" valid:"required,past,after=1900-01-01T00:00:00Z"`
+	Note  string
+}
+
+type Account struct {
+	Base
+	Role    Role       `json:"role" valid:"required,oneof=admin user"`
+	RolePtr *Role      `json:"role_ptr" valid:"optional,oneof=admin user"`
+	Count   *int       `json:"count" valid:"optional,min=1"`
+	Small   *int32     `json:"small" valid:"max=100"`
+	Level   Level      `json:"level" valid:"between=1 10"`
+	Small8  uint8      `json:"small8" valid:"max=200"`
+	Owner   *Address   `json:"owner" valid:"required"`
+	Names   *[]string  `json:"names" valid:"min=1"`
+	Lines   []Address  `json:"lines" valid:"dive"`
+	At      *time.Time `json:"at" valid:"nullable,past"`
+}
@@ -0,0 +1,106 @@
+package valid
+
+import "reflect"
+
+// When applies the given rules only if cond is true
+func (b *StringRuleBuilder) When(cond bool, opts ...StringOption) *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		if cond {
+			sv.run(opts)
+		}
+	})
+	return b
+}
+
+// Unless applies the given rules only if cond is false
+func (b *StringRuleBuilder) Unless(cond bool, opts ...StringOption) *StringRuleBuilder {
+	return b.When(!cond, opts...)
+}
+
+// When applies the given rules only if cond is true
+func (b *NumberRuleBuilder[T]) When(cond bool, opts ...NumberOption[T]) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if cond {
+			nv.run(opts)
+		}
+	})
+	return b
+}
+
+// Unless applies the given rules only if cond is false
+func (b *NumberRuleBuilder[T]) Unless(cond bool, opts ...NumberOption[T]) *NumberRuleBuilder[T] {
+	return b.When(!cond, opts...)
+}
+
+// When applies the given rules only if cond is true
+func (b *Float64RuleBuilder[T]) When(cond bool, opts ...Float64Option[T]) *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		if cond {
+			fv.run(opts)
+		}
+	})
+
+	return b
+}
+
+// Unless applies the given rules only if cond is false
+func (b *Float64RuleBuilder[T]) Unless(cond bool, opts ...Float64Option[T]) *Float64RuleBuilder[T] {
+	return b.When(!cond, opts...)
+}
+
+// When applies the given rules only if cond is true
+func (b *TimeRuleBuilder) When(cond bool, opts ...TimeOption) *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		if cond {
+			tv.run(opts)
+		}
+	})
+
+	return b
+}
+
+// Unless applies the given rules only if cond is false
+func (b *TimeRuleBuilder) Unless(cond bool, opts ...TimeOption) *TimeRuleBuilder {
+	return b.When(!cond, opts...)
+}
+
+// RequiredIf validates that value is not empty when cond is true
+func (v *Validator) RequiredIf(field string, value any, cond bool) {
+	if cond && isEmpty(value) {
+		v.AddError(field, MsgRequiredIf, nil)
+	}
+}
+
+// RequiredWith validates that value is not empty when the other field is present
+func (v *Validator) RequiredWith(field string, value any, otherField string, otherValue any) {
+	if !isEmpty(otherValue) && isEmpty(value) {
+		v.AddError(field, MsgRequiredWith, MessageParams{
+			"other": otherField,
+		})
+	}
+}
+
+// RequiredWithout validates that value is not empty when the other field is absent
+func (v *Validator) RequiredWithout(field string, value any, otherField string, otherValue any) {
+	if isEmpty(otherValue) && isEmpty(value) {
+		v.AddError(field, MsgRequiredWithout, MessageParams{
+			"other": otherField,
+		})
+	}
+}
+
+// isEmpty reports whether value is absent: nil, the zero value, or an empty
+// slice or map. Non-nil pointers are present even when they point to a zero value
+func isEmpty(value any) bool {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Interface:
+		return rv.IsNil()
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+
+	return rv.IsZero()
+}
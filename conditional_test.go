@@ -0,0 +1,62 @@
+package valid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWhenUnless(t *testing.T) {
+	v := New()
+	v.String("tax_id", "", StringRules().
+		When(true, StringRules().Required().Build()...).
+		Unless(true, StringRules().MinLength(5).Build()...).
+		Build()...)
+	v.Int("age", 10, NumberRules[int64]().
+		When(false, NumberRules[int64]().Min(18).Build()...).
+		Unless(false, NumberRules[int64]().Max(5).Build()...).
+		Build()...)
+	v.Float64("discount", 0.5, FloatRules[float64]().
+		When(true, FloatRules[float64]().Max(0.2).Build()...).
+		Build()...)
+	v.Time("ends", time.Time{}, TimeRules().
+		Unless(false, TimeRules().Required().Build()...).
+		Build()...)
+
+	assertErrors(t, v, "tax_id:required", "age:max_value", "discount:max_value", "ends:required")
+}
+
+func TestWhenStopsAtSkip(t *testing.T) {
+	v := New()
+	v.String("nickname", "", StringRules().
+		When(true, StringRules().Optional().Build()...).
+		MinLength(3).
+		Build()...)
+
+	assertErrors(t, v)
+}
+
+func TestRequiredIf(t *testing.T) {
+	zero := 0
+
+	v := New()
+	v.RequiredIf("company", "", true)
+	v.RequiredIf("vat", "", false)
+	v.RequiredIf("count", &zero, true)
+	v.RequiredIf("tags", []string{}, true)
+
+	assertErrors(t, v, "company:required_if", "tags:required_if")
+}
+
+func TestRequiredWithWithout(t *testing.T) {
+	v := New()
+	v.RequiredWith("password_confirmation", "", "password", "secret")
+	v.RequiredWith("zip", "", "city", "")
+	v.RequiredWithout("phone", "", "email", "")
+	v.RequiredWithout("fax", "", "email", "a@example.com")
+
+	assertErrors(t, v, "password_confirmation:required_with", "phone:required_without")
+
+	if got := v.Errors()[0].Params["other"]; got != "password" {
+		t.Errorf("other param = %v, want password", got)
+	}
+}
@@ -0,0 +1,33 @@
+package valid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCrossField(t *testing.T) {
+	start := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	v := New(WithLocale(LocaleEN))
+	v.String("password_confirmation", "secret1", StringRules().EqualTo("password", "secret").Build()...)
+	v.String("new_password", "secret", StringRules().NotEqualTo("old_password", "secret").Build()...)
+	v.Int("max", 5, NumberRules[int64]().GreaterThanField("min", 5).Build()...)
+	v.Int("min", 5, NumberRules[int64]().LessThanField("max", 4).EqualTo("floor", 5).NotEqualTo("ceiling", 6).Build()...)
+	v.Float64("to", 1.5, FloatRules[float64]().GreaterThanField("from", 2).LessThanField("limit", 3).Build()...)
+	v.Time("ends_at", start, TimeRules().AfterField("starts_at", start).BeforeField("deadline", start.AddDate(0, 0, 1)).Build()...)
+	v.Time("starts_at", start, TimeRules().BeforeField("ends_at", start).Build()...)
+
+	assertErrors(t, v,
+		"password_confirmation:equal_to",
+		"new_password:not_equal_to",
+		"max:greater_than_field",
+		"min:less_than_field",
+		"to:greater_than_field",
+		"ends_at:after_field",
+		"starts_at:before_field",
+	)
+
+	if got, want := v.Errors()[0].Message, "must match password"; got != want {
+		t.Errorf("message = %q, want %q", got, want)
+	}
+}
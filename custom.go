@@ -0,0 +1,197 @@
+package valid
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Rule function types for custom checks. They return false along with the
+// message key and params of the error when the value is invalid
+type (
+	StringRuleFunc                        func(value string) (MessageKey, MessageParams, bool)
+	NumberRuleFunc[T constraints.Integer] func(value T) (MessageKey, MessageParams, bool)
+	FloatRuleFunc[T constraints.Float]    func(value T) (MessageKey, MessageParams, bool)
+	TimeRuleFunc                          func(value time.Time) (MessageKey, MessageParams, bool)
+)
+
+// ruleRegistry holds custom rules referenced by name
+type ruleRegistry struct {
+	mu      sync.RWMutex
+	strings map[string]StringRuleFunc
+	numbers map[string]NumberRuleFunc[int64]
+	floats  map[string]FloatRuleFunc[float64]
+	times   map[string]TimeRuleFunc
+}
+
+// globalRules is the registry shared by every validator
+var globalRules = newRuleRegistry()
+
+func newRuleRegistry() *ruleRegistry {
+	return &ruleRegistry{
+		strings: make(map[string]StringRuleFunc),
+		numbers: make(map[string]NumberRuleFunc[int64]),
+		floats:  make(map[string]FloatRuleFunc[float64]),
+		times:   make(map[string]TimeRuleFunc),
+	}
+}
+
+// RegisterStringRule registers a named string rule available to every validator
+func RegisterStringRule(name string, fn StringRuleFunc) {
+	register(globalRules, globalRules.strings, name, fn)
+}
+
+// RegisterNumberRule registers a named integer rule available to every validator
+func RegisterNumberRule(name string, fn NumberRuleFunc[int64]) {
+	register(globalRules, globalRules.numbers, name, fn)
+}
+
+// RegisterFloatRule registers a named float rule available to every validator
+func RegisterFloatRule(name string, fn FloatRuleFunc[float64]) {
+	register(globalRules, globalRules.floats, name, fn)
+}
+
+// RegisterTimeRule registers a named time rule available to every validator
+func RegisterTimeRule(name string, fn TimeRuleFunc) {
+	register(globalRules, globalRules.times, name, fn)
+}
+
+// RegisterStringRule registers a named string rule available to this validator
+// only, taking precedence over the global rule with the same name
+func (v *Validator) RegisterStringRule(name string, fn StringRuleFunc) {
+	register(v.shared.rules, v.shared.rules.strings, name, fn)
+}
+
+// RegisterNumberRule registers a named integer rule available to this validator
+// only, taking precedence over the global rule with the same name
+func (v *Validator) RegisterNumberRule(name string, fn NumberRuleFunc[int64]) {
+	register(v.shared.rules, v.shared.rules.numbers, name, fn)
+}
+
+// RegisterFloatRule registers a named float rule available to this validator
+// only, taking precedence over the global rule with the same name
+func (v *Validator) RegisterFloatRule(name string, fn FloatRuleFunc[float64]) {
+	register(v.shared.rules, v.shared.rules.floats, name, fn)
+}
+
+// RegisterTimeRule registers a named time rule available to this validator
+// only, taking precedence over the global rule with the same name
+func (v *Validator) RegisterTimeRule(name string, fn TimeRuleFunc) {
+	register(v.shared.rules, v.shared.rules.times, name, fn)
+}
+
+func register[F any](r *ruleRegistry, rules map[string]F, name string, fn F) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rules[name] = fn
+}
+
+// lookupRule finds a named rule in the validator registry, then in the global
+// one. Referencing a rule that was never registered is a programming error, so
+// it panics
+func lookupRule[F any](v *Validator, kind string, rules func(*ruleRegistry) map[string]F, name string) F {
+	for _, r := range []*ruleRegistry{v.shared.rules, globalRules} {
+		r.mu.RLock()
+		fn, ok := rules(r)[name]
+		r.mu.RUnlock()
+
+		if ok {
+			return fn
+		}
+	}
+
+	panic(fmt.Sprintf("valid: unknown %s rule %q", kind, name))
+}
+
+// Custom validates the string with a custom check
+func (b *StringRuleBuilder) Custom(fn StringRuleFunc) *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		if key, params, ok := fn(sv.value); !ok {
+			sv.v.AddError(sv.field, key, params)
+		}
+	})
+	return b
+}
+
+// Rule validates the string with a rule registered under name
+func (b *StringRuleBuilder) Rule(name string) *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		fn := lookupRule(sv.v, "string", func(r *ruleRegistry) map[string]StringRuleFunc { return r.strings }, name)
+		if key, params, ok := fn(sv.value); !ok {
+			sv.v.AddError(sv.field, key, params)
+		}
+	})
+	return b
+}
+
+// Custom validates the number with a custom check
+func (b *NumberRuleBuilder[T]) Custom(fn NumberRuleFunc[T]) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if key, params, ok := fn(nv.value); !ok {
+			nv.v.AddError(nv.field, key, params)
+		}
+	})
+	return b
+}
+
+// Rule validates the number with a rule registered under name. Registered
+// number rules receive the value as an int64
+func (b *NumberRuleBuilder[T]) Rule(name string) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		fn := lookupRule(nv.v, "number", func(r *ruleRegistry) map[string]NumberRuleFunc[int64] { return r.numbers }, name)
+		if key, params, ok := fn(int64(nv.value)); !ok {
+			nv.v.AddError(nv.field, key, params)
+		}
+	})
+	return b
+}
+
+// Custom validates the float with a custom check
+func (b *Float64RuleBuilder[T]) Custom(fn FloatRuleFunc[T]) *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		if key, params, ok := fn(fv.value); !ok {
+			fv.v.AddError(fv.field, key, params)
+		}
+	})
+
+	return b
+}
+
+// Rule validates the float with a rule registered under name. Registered float
+// rules receive the value as a float64
+func (b *Float64RuleBuilder[T]) Rule(name string) *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		fn := lookupRule(fv.v, "float", func(r *ruleRegistry) map[string]FloatRuleFunc[float64] { return r.floats }, name)
+		if key, params, ok := fn(float64(fv.value)); !ok {
+			fv.v.AddError(fv.field, key, params)
+		}
+	})
+
+	return b
+}
+
+// Custom validates the time with a custom check
+func (b *TimeRuleBuilder) Custom(fn TimeRuleFunc) *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		if key, params, ok := fn(tv.value); !ok {
+			tv.v.AddError(tv.field, key, params)
+		}
+	})
+
+	return b
+}
+
+// Rule validates the time with a rule registered under name
+func (b *TimeRuleBuilder) Rule(name string) *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		fn := lookupRule(tv.v, "time", func(r *ruleRegistry) map[string]TimeRuleFunc { return r.times }, name)
+		if key, params, ok := fn(tv.value); !ok {
+			tv.v.AddError(tv.field, key, params)
+		}
+	})
+
+	return b
+}
@@ -0,0 +1,83 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCustom(t *testing.T) {
+	even := func(n int32) (MessageKey, MessageParams, bool) {
+		return "even", nil, n%2 == 0
+	}
+
+	v := New()
+	v.String("slug", "Hello World", StringRules().Custom(func(s string) (MessageKey, MessageParams, bool) {
+		return "slug", MessageParams{"value": s}, !strings.Contains(s, " ")
+	}).Build()...)
+	NumberPtr(v, "count", new(int32), NumberRules[int32]().Custom(even).Build()...)
+	v.Float64("ratio", 2, FloatRules[float64]().Custom(func(f float64) (MessageKey, MessageParams, bool) {
+		return "ratio", nil, f <= 1
+	}).Build()...)
+	v.Time("at", time.Time{}, TimeRules().Custom(func(t time.Time) (MessageKey, MessageParams, bool) {
+		return "weekday", nil, t.Weekday() != time.Monday
+	}).Build()...)
+
+	assertErrors(t, v, "slug:slug", "ratio:ratio", "at:weekday")
+
+	if got := v.Errors()[0].Params["value"]; got != "Hello World" {
+		t.Errorf("value param = %v, want %q", got, "Hello World")
+	}
+}
+
+func TestNamedRules(t *testing.T) {
+	RegisterStringRule("test_lowercase", func(s string) (MessageKey, MessageParams, bool) {
+		return "lowercase", nil, s == strings.ToLower(s)
+	})
+	RegisterNumberRule("test_positive", func(n int64) (MessageKey, MessageParams, bool) {
+		return "positive", nil, n > 0
+	})
+	RegisterFloatRule("test_unit", func(f float64) (MessageKey, MessageParams, bool) {
+		return "unit", nil, f >= 0 && f <= 1
+	})
+	RegisterTimeRule("test_set", func(t time.Time) (MessageKey, MessageParams, bool) {
+		return "unset", nil, !t.IsZero()
+	})
+
+	v := New()
+	// Validator rules take precedence over global ones with the same name
+	v.RegisterStringRule("test_lowercase", func(s string) (MessageKey, MessageParams, bool) {
+		return "uppercase", nil, s == strings.ToUpper(s)
+	})
+
+	v.String("code", "abc", StringRules().Rule("test_lowercase").Build()...)
+	v.Int("count", 0, NumberRules[int64]().Rule("test_positive").Build()...)
+	v.Uint("size", 3, NumberRules[uint]().Rule("test_positive").Build()...)
+	v.Float32("ratio", 1.5, FloatRules[float32]().Rule("test_unit").Build()...)
+	v.Time("at", time.Time{}, TimeRules().Rule("test_set").Build()...)
+
+	assertErrors(t, v, "code:uppercase", "count:positive", "ratio:unit", "at:unset")
+
+	other := New()
+	other.String("code", "ABC", StringRules().Rule("test_lowercase").Build()...)
+
+	assertErrors(t, other, "code:lowercase")
+}
+
+func TestNamedRuleTags(t *testing.T) {
+	v := New()
+	v.RegisterStringRule("test_no_spaces", func(s string) (MessageKey, MessageParams, bool) {
+		return "no_spaces", nil, !strings.Contains(s, " ")
+	})
+	v.Struct(struct {
+		Slug string `json:"slug" valid:"required,rule=test_no_spaces"`
+	}{Slug: "a b"})
+
+	assertErrors(t, v, "slug:no_spaces")
+}
+
+func TestUnknownRule(t *testing.T) {
+	assertPanics(t, func() {
+		New().String("code", "", StringRules().Rule("test_missing").Build()...)
+	})
+}
@@ -0,0 +1,96 @@
+package valid
+
+import "errors"
+
+// ErrValidation matches every validation error, so errors.Is(err, ErrValidation)
+// tells validation failures from other errors even once wrapped
+var ErrValidation = errors.New("valid: validation failed")
+
+// Sentinel errors of the built-in message keys. A validation error matches the
+// sentinel of its message key, e.g. errors.Is(err, ErrRequired)
+var (
+	ErrRequired         = ErrorForKey(MsgRequired)
+	ErrMinLength        = ErrorForKey(MsgMinLength)
+	ErrMaxLength        = ErrorForKey(MsgMaxLength)
+	ErrEmail            = ErrorForKey(MsgEmail)
+	ErrMinValue         = ErrorForKey(MsgMinValue)
+	ErrMaxValue         = ErrorForKey(MsgMaxValue)
+	ErrBetween          = ErrorForKey(MsgBetween)
+	ErrPrecision        = ErrorForKey(MsgPrecision)
+	ErrPast             = ErrorForKey(MsgPast)
+	ErrFuture           = ErrorForKey(MsgFuture)
+	ErrAfter            = ErrorForKey(MsgAfter)
+	ErrBefore           = ErrorForKey(MsgBefore)
+	ErrBetweenDates     = ErrorForKey(MsgBetweenDates)
+	ErrWeekday          = ErrorForKey(MsgWeekday)
+	ErrMaxAge           = ErrorForKey(MsgMaxAge)
+	ErrMinAge           = ErrorForKey(MsgMinAge)
+	ErrSliceRequired    = ErrorForKey(MsgSliceRequired)
+	ErrSliceMinLength   = ErrorForKey(MsgSliceMinLength)
+	ErrSliceMaxLength   = ErrorForKey(MsgSliceMaxLength)
+	ErrSliceLength      = ErrorForKey(MsgSliceLength)
+	ErrSliceMin         = ErrorForKey(MsgSliceMin)
+	ErrSliceMax         = ErrorForKey(MsgSliceMax)
+	ErrSliceBetween     = ErrorForKey(MsgSliceBetween)
+	ErrInvalidUUID      = ErrorForKey(MsgInvalidUUID)
+	ErrOneOf            = ErrorForKey(MsgOneOf)
+	ErrMapRequired      = ErrorForKey(MsgMapRequired)
+	ErrMapMinKeys       = ErrorForKey(MsgMapMinKeys)
+	ErrMapMaxKeys       = ErrorForKey(MsgMapMaxKeys)
+	ErrMapKeyNotAllowed = ErrorForKey(MsgMapKeyNotAllowed)
+	ErrNotNil           = ErrorForKey(MsgNotNil)
+	ErrRequiredIf       = ErrorForKey(MsgRequiredIf)
+	ErrRequiredWith     = ErrorForKey(MsgRequiredWith)
+	ErrRequiredWithout  = ErrorForKey(MsgRequiredWithout)
+	ErrEqualTo          = ErrorForKey(MsgEqualTo)
+	ErrNotEqualTo       = ErrorForKey(MsgNotEqualTo)
+	ErrGreaterThanField = ErrorForKey(MsgGreaterThanField)
+	ErrLessThanField    = ErrorForKey(MsgLessThanField)
+	ErrAfterField       = ErrorForKey(MsgAfterField)
+	ErrBeforeField      = ErrorForKey(MsgBeforeField)
+	ErrAlreadyExists    = ErrorForKey(MsgAlreadyExists)
+	ErrNotFound         = ErrorForKey(MsgNotFound)
+)
+
+// keyError is the sentinel error of a message key
+type keyError MessageKey
+
+func (e keyError) Error() string {
+	return "valid: " + string(e)
+}
+
+// ErrorForKey returns the sentinel error of a message key, e.g. of a custom
+// rule. Sentinels of the same key are equal
+func ErrorForKey(key MessageKey) error {
+	return keyError(key)
+}
+
+// Is reports whether target is ErrValidation or the sentinel of the error's
+// message key
+func (e ValidationError) Is(target error) bool {
+	if target == ErrValidation {
+		return true
+	}
+
+	key, ok := target.(keyError)
+
+	return ok && MessageKey(key) == e.MessageKey
+}
+
+// Is reports whether target is ErrValidation and there is at least one
+// error. The sentinels of message keys are matched by the errors returned by
+// Unwrap
+func (v ValidationErrors) Is(target error) bool {
+	return target == ErrValidation && len(v) > 0
+}
+
+// Unwrap returns the individual errors, so errors.Is and errors.As look into
+// them, also when the errors are wrapped or joined with errors.Join
+func (v ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(v))
+	for i, err := range v {
+		errs[i] = err
+	}
+
+	return errs
+}
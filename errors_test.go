@@ -0,0 +1,74 @@
+package valid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorsIs(t *testing.T) {
+	v := New()
+	v.String("email", "", StringRules().Required().Build()...)
+	v.Int("age", 10, NumberRules[int64]().Min(18).Build()...)
+	v.AddError("slug", "slug_taken", nil)
+
+	err := fmt.Errorf("create user: %w", v.Errors())
+
+	for _, target := range []error{ErrValidation, ErrRequired, ErrMinValue, ErrorForKey("slug_taken")} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(err, %v) = false", target)
+		}
+	}
+
+	for _, target := range []error{ErrEmail, ErrorForKey("other"), errors.New("valid: required")} {
+		if errors.Is(err, target) {
+			t.Errorf("errors.Is(err, %v) = true", target)
+		}
+	}
+
+	joined := errors.Join(errors.New("db"), v.Errors()[1])
+	if !errors.Is(joined, ErrValidation) || !errors.Is(joined, ErrMinValue) || errors.Is(joined, ErrRequired) {
+		t.Error("a single joined validation error is not matched by its sentinels")
+	}
+
+	if errors.Is(errors.New("db"), ErrValidation) {
+		t.Error("other errors match ErrValidation")
+	}
+
+	var empty error = New().Errors()
+	if errors.Is(empty, ErrValidation) || errors.Is(fmt.Errorf("create user: %w", empty), ErrValidation) {
+		t.Error("empty validation errors match ErrValidation")
+	}
+}
+
+func TestErrorsAs(t *testing.T) {
+	v := New()
+	v.String("email", "", StringRules().Required().Build()...)
+	err := fmt.Errorf("create user: %w", v.Errors())
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("errors.As(ValidationErrors) = %v", errs)
+	}
+
+	var single ValidationError
+	if !errors.As(err, &single) || single.Field != "email" {
+		t.Errorf("errors.As(ValidationError) = %+v", single)
+	}
+}
+
+func TestErrorForKey(t *testing.T) {
+	for _, key := range messageKeys(t) {
+		if ErrorForKey(key) != ErrorForKey(key) {
+			t.Errorf("sentinels of %s differ", key)
+		}
+
+		if err := (ValidationError{MessageKey: key}); !errors.Is(err, ErrorForKey(key)) {
+			t.Errorf("an error with key %s does not match its sentinel", key)
+		}
+	}
+
+	if ErrRequired != ErrorForKey(MsgRequired) || ErrRequired.Error() != "valid: required" {
+		t.Errorf("ErrRequired = %v", ErrRequired)
+	}
+}
@@ -7,20 +7,54 @@ type Float64Validator[T constraints.Float] struct {
 	v     *Validator
 	field string
 	value T
+	isNil bool
+	isPtr bool
+	skip  bool
 }
 
 // Float64 validates a floating-point field with the given options
 func (v *Validator) Float64(field string, value float64, opts ...Float64Option[float64]) {
 	fv := &Float64Validator[float64]{v: v, field: field, value: value}
-	for _, opt := range opts {
-		opt(fv)
-	}
+	fv.run(opts)
 }
 
 // Float32 validates a floating-point field with the given options
 func (v *Validator) Float32(field string, value float32, opts ...Float64Option[float32]) {
 	fv := &Float64Validator[float32]{v: v, field: field, value: value}
+	fv.run(opts)
+}
+
+// Float64Ptr validates an optional floating-point field with the given options.
+// A nil pointer is validated as zero unless Optional or Nullable skip it
+func (v *Validator) Float64Ptr(field string, value *float64, opts ...Float64Option[float64]) {
+	newFloatPtrValidator(v, field, value).run(opts)
+}
+
+// Float32Ptr validates an optional floating-point field with the given options.
+// A nil pointer is validated as zero unless Optional or Nullable skip it
+func (v *Validator) Float32Ptr(field string, value *float32, opts ...Float64Option[float32]) {
+	newFloatPtrValidator(v, field, value).run(opts)
+}
+
+func newFloatPtrValidator[T constraints.Float](v *Validator, field string, value *T) *Float64Validator[T] {
+	fv := &Float64Validator[T]{v: v, field: field, isNil: value == nil, isPtr: true}
+	if value != nil {
+		fv.value = *value
+	}
+
+	return fv
+}
+
+// run applies the options until one of them skips the remaining rules or,
+// with WithFailFast, adds an error
+func (fv *Float64Validator[T]) run(opts []Float64Option[T]) {
+	var failed func() bool
+	fv.v, failed = fv.v.failFast()
+
 	for _, opt := range opts {
+		if fv.skip || failed() {
+			return
+		}
 		opt(fv)
 	}
 }
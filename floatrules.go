@@ -2,10 +2,47 @@ package valid
 
 import "math"
 
-// Required validates that the float is not zero
+// Optional skips the remaining rules when the float is zero or, for pointers,
+// only when it is nil
+func (b *Float64RuleBuilder[T]) Optional() *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		if fv.isNil || !fv.isPtr && fv.value == 0 {
+			fv.skip = true
+		}
+	})
+
+	return b
+}
+
+// Nullable skips the remaining rules when the float is nil, so zero is still
+// validated
+func (b *Float64RuleBuilder[T]) Nullable() *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		if fv.isNil {
+			fv.skip = true
+		}
+	})
+
+	return b
+}
+
+// NotNil validates that the float is present, accepting zero
+func (b *Float64RuleBuilder[T]) NotNil() *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		if fv.isNil {
+			fv.v.AddError(fv.field, MsgNotNil, nil)
+			fv.skip = true
+		}
+	})
+
+	return b
+}
+
+// Required validates that the float is not zero or, for pointers, that it is
+// not nil, so a pointer to zero is accepted
 func (b *Float64RuleBuilder[T]) Required() *Float64RuleBuilder[T] {
 	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
-		if fv.value == 0 {
+		if fv.isNil || !fv.isPtr && fv.value == 0 {
 			fv.v.AddError(fv.field, MsgRequired, nil)
 		}
 	})
@@ -21,7 +58,7 @@ func (b *Float64RuleBuilder[T]) Precision(decimals int) *Float64RuleBuilder[T] {
 
 		if fv.value != T(truncated) {
 			fv.v.AddError(fv.field, MsgPrecision, MessageParams{
-				"d": decimals,
+				"decimals": decimals,
 			})
 		}
 	})
@@ -34,7 +71,7 @@ func (b *Float64RuleBuilder[T]) Min(min T) *Float64RuleBuilder[T] {
 	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
 		if fv.value < min {
 			fv.v.AddError(fv.field, MsgMinValue, MessageParams{
-				"v": min,
+				"min": min,
 			})
 		}
 	})
@@ -47,7 +84,7 @@ func (b *Float64RuleBuilder[T]) Max(max T) *Float64RuleBuilder[T] {
 	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
 		if fv.value > max {
 			fv.v.AddError(fv.field, MsgMaxValue, MessageParams{
-				"v": max,
+				"max": max,
 			})
 		}
 	})
@@ -60,8 +97,34 @@ func (b *Float64RuleBuilder[T]) Between(min, max T) *Float64RuleBuilder[T] {
 	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
 		if fv.value < min || fv.value > max {
 			fv.v.AddError(fv.field, MsgBetween, MessageParams{
-				"v1": min,
-				"v2": max,
+				"min": min,
+				"max": max,
+			})
+		}
+	})
+
+	return b
+}
+
+// GreaterThanField validates that the float is greater than the value of another field
+func (b *Float64RuleBuilder[T]) GreaterThanField(otherField string, otherValue T) *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		if fv.value <= otherValue {
+			fv.v.AddError(fv.field, MsgGreaterThanField, MessageParams{
+				"other": otherField,
+			})
+		}
+	})
+
+	return b
+}
+
+// LessThanField validates that the float is less than the value of another field
+func (b *Float64RuleBuilder[T]) LessThanField(otherField string, otherValue T) *Float64RuleBuilder[T] {
+	b.rules = append(b.rules, func(fv *Float64Validator[T]) {
+		if fv.value >= otherValue {
+			fv.v.AddError(fv.field, MsgLessThanField, MessageParams{
+				"other": otherField,
 			})
 		}
 	})
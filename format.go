@@ -0,0 +1,255 @@
+package valid
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Formatter renders validation errors in an output format, as a value ready to
+// be encoded as JSON
+type Formatter interface {
+	Format(errs ValidationErrors) any
+}
+
+// Format renders the errors with the given formatter
+func (v ValidationErrors) Format(f Formatter) any {
+	return f.Format(v)
+}
+
+// JSONAPIFormatter renders errors as a JSON:API document, pointing each error
+// to its member, e.g. items[2].name to /data/attributes/items/2/name
+type JSONAPIFormatter struct {
+	// Prefix is the JSON pointer of the validated object, /data/attributes by default
+	Prefix string
+	// Status is the HTTP status code of every error, 422 by default
+	Status int
+}
+
+// JSONAPIDocument is a JSON:API error document
+type JSONAPIDocument struct {
+	Errors []JSONAPIError `json:"errors"`
+}
+
+// JSONAPIError is a JSON:API error object
+type JSONAPIError struct {
+	Status string         `json:"status"`
+	Code   MessageKey     `json:"code"`
+	Detail string         `json:"detail"`
+	Source JSONAPISource  `json:"source"`
+	Meta   map[string]any `json:"meta,omitempty"`
+}
+
+// JSONAPISource points to the member of the request document an error comes from
+type JSONAPISource struct {
+	Pointer string `json:"pointer"`
+}
+
+// Format implements Formatter, returning a JSONAPIDocument
+func (f JSONAPIFormatter) Format(errs ValidationErrors) any {
+	prefix := f.Prefix
+	if prefix == "" {
+		prefix = "/data/attributes"
+	}
+
+	status := f.Status
+	if status == 0 {
+		status = http.StatusUnprocessableEntity
+	}
+
+	doc := JSONAPIDocument{Errors: make([]JSONAPIError, len(errs))}
+	for i, err := range errs {
+		doc.Errors[i] = JSONAPIError{
+			Status: strconv.Itoa(status),
+			Code:   err.MessageKey,
+			Detail: err.Message,
+			Source: JSONAPISource{Pointer: prefix + jsonPointer(err.Field)},
+			Meta:   err.Params,
+		}
+	}
+
+	return doc
+}
+
+// GraphQLFormatter renders errors as a single GraphQL error, listing them in
+// its extensions.validation member with their path split as GraphQL does, e.g.
+// ["items", 2, "name"]
+type GraphQLFormatter struct {
+	// Message is the message of the GraphQL error, "validation failed" by default
+	Message string
+	// Code is the extensions.code of the GraphQL error, BAD_USER_INPUT by default
+	Code string
+}
+
+// GraphQLError is a GraphQL error carrying validation errors
+type GraphQLError struct {
+	Message    string            `json:"message"`
+	Extensions GraphQLExtensions `json:"extensions"`
+}
+
+// GraphQLExtensions is the extensions member of a GraphQLError
+type GraphQLExtensions struct {
+	Code       string                   `json:"code"`
+	Validation []GraphQLValidationError `json:"validation"`
+}
+
+// GraphQLValidationError is a validation error with its path split in segments
+type GraphQLValidationError struct {
+	Path       []any         `json:"path"`
+	Message    string        `json:"message"`
+	MessageKey MessageKey    `json:"message_key"`
+	Params     MessageParams `json:"params,omitempty"`
+}
+
+// Format implements Formatter, returning a []GraphQLError to be merged into
+// the errors of the response
+func (f GraphQLFormatter) Format(errs ValidationErrors) any {
+	gqlErr := GraphQLError{
+		Message: f.Message,
+		Extensions: GraphQLExtensions{
+			Code:       f.Code,
+			Validation: make([]GraphQLValidationError, len(errs)),
+		},
+	}
+
+	if gqlErr.Message == "" {
+		gqlErr.Message = "validation failed"
+	}
+	if gqlErr.Extensions.Code == "" {
+		gqlErr.Extensions.Code = "BAD_USER_INPUT"
+	}
+
+	for i, err := range errs {
+		gqlErr.Extensions.Validation[i] = GraphQLValidationError{
+			Path:       splitPath(err.Field),
+			Message:    err.Message,
+			MessageKey: err.MessageKey,
+			Params:     err.Params,
+		}
+	}
+
+	return []GraphQLError{gqlErr}
+}
+
+// TreeFormatter renders errors as nested objects mirroring the shape of the
+// payload, e.g. {"address": {"street": ["field is required"]}}. Indexes and map
+// keys become object keys. A field with errors of its own and of its members
+// holds its own errors under "_errors", as do errors of the whole payload,
+// reported with an empty field
+type TreeFormatter struct {
+	// Keys lists the message keys of the errors instead of their messages
+	Keys bool
+}
+
+// Format implements Formatter, returning a map[string]any
+func (f TreeFormatter) Format(errs ValidationErrors) any {
+	tree := make(map[string]any)
+	for _, err := range errs {
+		msg := err.Message
+		if f.Keys {
+			msg = string(err.MessageKey)
+		}
+
+		// Errors of the whole payload, with an empty field, are its own errors
+		segments := splitPath(err.Field)
+		if len(segments) == 0 {
+			msgs, _ := tree["_errors"].([]string)
+			tree["_errors"] = append(msgs, msg)
+			continue
+		}
+
+		node := tree
+		for i, segment := range segments {
+			key := formatParam(segment)
+			if i == len(segments)-1 {
+				addTreeMessage(node, key, msg)
+				break
+			}
+
+			node = treeChild(node, key)
+		}
+	}
+
+	return tree
+}
+
+// treeChild returns the object under key, creating it, or moving the messages
+// already there to its _errors member
+func treeChild(node map[string]any, key string) map[string]any {
+	switch child := node[key].(type) {
+	case map[string]any:
+		return child
+	case []string:
+		obj := map[string]any{"_errors": child}
+		node[key] = obj
+		return obj
+	}
+
+	obj := make(map[string]any)
+	node[key] = obj
+
+	return obj
+}
+
+// addTreeMessage adds msg to the messages under key
+func addTreeMessage(node map[string]any, key, msg string) {
+	switch child := node[key].(type) {
+	case map[string]any:
+		msgs, _ := child["_errors"].([]string)
+		child["_errors"] = append(msgs, msg)
+	case []string:
+		node[key] = append(child, msg)
+	default:
+		node[key] = []string{msg}
+	}
+}
+
+// splitPath splits a field path into its segments: field names and map keys
+// as strings and indexes as ints, e.g. items[2].prices[usd] into
+// ["items", 2, "prices", "usd"]
+func splitPath(path string) []any {
+	var segments []any
+	for path != "" {
+		switch {
+		case path[0] == '.':
+			path = path[1:]
+		case path[0] == '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return append(segments, path)
+			}
+
+			key := path[1:end]
+			if i, err := strconv.Atoi(key); err == nil {
+				segments = append(segments, i)
+			} else {
+				segments = append(segments, key)
+			}
+			path = path[end+1:]
+		default:
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+
+			segments = append(segments, path[:end])
+			path = path[end:]
+		}
+	}
+
+	return segments
+}
+
+// jsonPointer returns the RFC 6901 JSON pointer of a field path, e.g.
+// /items/2/name for items[2].name
+func jsonPointer(path string) string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+
+	var b strings.Builder
+	for _, segment := range splitPath(path) {
+		b.WriteString("/")
+		b.WriteString(escaper.Replace(formatParam(segment)))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,116 @@
+package valid
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// formatErrors returns the errors used by the formatter tests
+func formatErrors() ValidationErrors {
+	v := New(WithLocale(LocaleEN))
+	v.AddError("", "invalid_payload", nil)
+	v.Scope("items").Index(2).String("name", "", StringRules().Required().Build()...)
+	v.String("items", "", StringRules().Required().Build()...)
+	v.AddError("prices[usd/eur]", MsgMinValue, MessageParams{"min": 1})
+
+	return v.Errors()
+}
+
+// toJSON marshals value and unmarshals it back, so results are compared as the
+// clients see them
+func toJSON(t *testing.T, value any) any {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	return decoded
+}
+
+func TestTreeFormatter(t *testing.T) {
+	got := toJSON(t, formatErrors().Format(TreeFormatter{Keys: true}))
+	want := toJSON(t, map[string]any{
+		"_errors": []string{"invalid_payload"},
+		"items": map[string]any{
+			"2":       map[string]any{"name": []string{"required"}},
+			"_errors": []string{"required"},
+		},
+		"prices": map[string]any{"usd/eur": []string{"min_value"}},
+	})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Format() = %v, want %v", got, want)
+	}
+
+	// Messages of a field come before its members
+	v := New(WithLocale(LocaleEN))
+	v.AddError("address", MsgRequired, nil)
+	v.AddError("address.street", MsgRequired, nil)
+
+	tree := v.Errors().Format(TreeFormatter{Keys: true}).(map[string]any)
+	if want := map[string]any{"_errors": []string{"required"}, "street": []string{"required"}}; !reflect.DeepEqual(tree["address"], want) {
+		t.Errorf("address = %v, want %v", tree["address"], want)
+	}
+}
+
+func TestJSONAPIFormatter(t *testing.T) {
+	doc := formatErrors().Format(JSONAPIFormatter{}).(JSONAPIDocument)
+
+	var pointers []string
+	for _, err := range doc.Errors {
+		pointers = append(pointers, err.Source.Pointer)
+		if err.Status != "422" {
+			t.Errorf("status = %q, want 422", err.Status)
+		}
+	}
+
+	want := []string{"/data/attributes", "/data/attributes/items/2/name", "/data/attributes/items", "/data/attributes/prices/usd~1eur"}
+	if !reflect.DeepEqual(pointers, want) {
+		t.Errorf("pointers = %q, want %q", pointers, want)
+	}
+
+	custom := formatErrors()[3:].Format(JSONAPIFormatter{Prefix: "/data", Status: 400}).(JSONAPIDocument)
+	if err := custom.Errors[0]; err.Source.Pointer != "/data/prices/usd~1eur" || err.Status != "400" || err.Meta["min"] != 1 {
+		t.Errorf("error = %+v", err)
+	}
+}
+
+func TestGraphQLFormatter(t *testing.T) {
+	errs := formatErrors().Format(GraphQLFormatter{}).([]GraphQLError)
+	if len(errs) != 1 || errs[0].Message != "validation failed" || errs[0].Extensions.Code != "BAD_USER_INPUT" {
+		t.Fatalf("Format() = %+v", errs)
+	}
+
+	if got := errs[0].Extensions.Validation[1].Path; !reflect.DeepEqual(got, []any{"items", 2, "name"}) {
+		t.Errorf("path = %v", got)
+	}
+
+	custom := formatErrors().Format(GraphQLFormatter{Message: "invalid input", Code: "VALIDATION"}).([]GraphQLError)
+	if custom[0].Message != "invalid input" || custom[0].Extensions.Code != "VALIDATION" {
+		t.Errorf("Format() = %+v", custom)
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	tests := map[string][]any{
+		"":                     nil,
+		"name":                 {"name"},
+		"items[2].prices[usd]": {"items", 2, "prices", "usd"},
+		"matrix[1][2]":         {"matrix", 1, 2},
+		"broken[1":             {"broken", "[1"},
+	}
+
+	for path, want := range tests {
+		if got := splitPath(path); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitPath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
@@ -4,5 +4,5 @@ go 1.22.6
 
 require (
 	github.com/google/uuid v1.6.0
-	golang.org/x/exp v0.0.0-20241009180824-f66d83c29e7c // indirect
+	golang.org/x/exp v0.0.0-20241009180824-f66d83c29e7c
 )
@@ -0,0 +1,42 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+// fieldKeys returns the errors as "field:message_key" strings, in order
+func fieldKeys(errs ValidationErrors) []string {
+	keys := make([]string, len(errs))
+	for i, err := range errs {
+		keys[i] = err.Field + ":" + string(err.MessageKey)
+	}
+
+	return keys
+}
+
+// assertErrors fails the test unless v holds exactly the given "field:key" errors, in order
+func assertErrors(t *testing.T, v *Validator, want ...string) {
+	t.Helper()
+
+	got := fieldKeys(v.Errors())
+	if len(got) == 0 && len(want) == 0 {
+		return
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("errors = %q, want %q", got, want)
+	}
+}
+
+// assertPanics fails the test unless fn panics
+func assertPanics(t *testing.T, fn func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	fn()
+}
@@ -5,43 +5,96 @@ type Locale string
 const (
 	LocaleES Locale = "es"
 	LocaleEN Locale = "en"
+	LocalePT Locale = "pt"
+	LocaleFR Locale = "fr"
+	LocaleDE Locale = "de"
+	LocaleIT Locale = "it"
 )
 
 type MessageKey string
 
 const (
 	// Field validations
-	MsgRequired       MessageKey = "required"
-	MsgMinLength      MessageKey = "min_length"
-	MsgMaxLength      MessageKey = "max_length"
-	MsgEmail          MessageKey = "email"
-	MsgMinValue       MessageKey = "min_value"
-	MsgMaxValue       MessageKey = "max_value"
-	MsgBetween        MessageKey = "between"
-	MsgPrecision      MessageKey = "precision"
-	MsgPast           MessageKey = "past"
-	MsgFuture         MessageKey = "future"
-	MsgAfter          MessageKey = "after"
-	MsgBefore         MessageKey = "before"
-	MsgBetweenDates   MessageKey = "between_dates"
-	MsgWeekday        MessageKey = "weekday"
-	MsgMaxAge         MessageKey = "max_age"
-	MsgMinAge         MessageKey = "min_age"
-	MsgSliceRequired  MessageKey = "slice_required"
-	MsgSliceMinLength MessageKey = "slice_min_length"
-	MsgSliceMaxLength MessageKey = "slice_max_length"
-	MsgSliceLength    MessageKey = "slice_length"
-	MsgSliceMin       MessageKey = "slice_min"
-	MsgSliceMax       MessageKey = "slice_max"
-	MsgSliceBetween   MessageKey = "slice_between"
-	MsgInvalidUUID    MessageKey = "invalid_uuid"
-	MsgOneOf          MessageKey = "one_of"
+	MsgRequired         MessageKey = "required"
+	MsgMinLength        MessageKey = "min_length"
+	MsgMaxLength        MessageKey = "max_length"
+	MsgEmail            MessageKey = "email"
+	MsgMinValue         MessageKey = "min_value"
+	MsgMaxValue         MessageKey = "max_value"
+	MsgBetween          MessageKey = "between"
+	MsgPrecision        MessageKey = "precision"
+	MsgPast             MessageKey = "past"
+	MsgFuture           MessageKey = "future"
+	MsgAfter            MessageKey = "after"
+	MsgBefore           MessageKey = "before"
+	MsgBetweenDates     MessageKey = "between_dates"
+	MsgWeekday          MessageKey = "weekday"
+	MsgMaxAge           MessageKey = "max_age"
+	MsgMinAge           MessageKey = "min_age"
+	MsgSliceRequired    MessageKey = "slice_required"
+	MsgSliceMinLength   MessageKey = "slice_min_length"
+	MsgSliceMaxLength   MessageKey = "slice_max_length"
+	MsgSliceLength      MessageKey = "slice_length"
+	MsgSliceMin         MessageKey = "slice_min"
+	MsgSliceMax         MessageKey = "slice_max"
+	MsgSliceBetween     MessageKey = "slice_between"
+	MsgInvalidUUID      MessageKey = "invalid_uuid"
+	MsgOneOf            MessageKey = "one_of"
+	MsgMapRequired      MessageKey = "map_required"
+	MsgMapMinKeys       MessageKey = "map_min_keys"
+	MsgMapMaxKeys       MessageKey = "map_max_keys"
+	MsgMapKeyNotAllowed MessageKey = "map_key_not_allowed"
+	MsgNotNil           MessageKey = "not_nil"
+	MsgRequiredIf       MessageKey = "required_if"
+	MsgRequiredWith     MessageKey = "required_with"
+	MsgRequiredWithout  MessageKey = "required_without"
+	MsgEqualTo          MessageKey = "equal_to"
+	MsgNotEqualTo       MessageKey = "not_equal_to"
+	MsgGreaterThanField MessageKey = "greater_than_field"
+	MsgLessThanField    MessageKey = "less_than_field"
+	MsgAfterField       MessageKey = "after_field"
+	MsgBeforeField      MessageKey = "before_field"
+	MsgAlreadyExists    MessageKey = "already_exists"
+	MsgNotFound         MessageKey = "not_found"
 )
 
+// messageParams lists the params each built-in key is reported with, besides
+// field, which every message can use. Catalogs are checked against it, so a
+// template cannot refer to a param that is never provided
+var messageParams = map[MessageKey][]string{
+	MsgMinLength:        {"min"},
+	MsgMaxLength:        {"max"},
+	MsgMinValue:         {"min"},
+	MsgMaxValue:         {"max"},
+	MsgBetween:          {"min", "max"},
+	MsgPrecision:        {"decimals"},
+	MsgAfter:            {"date"},
+	MsgBefore:           {"date"},
+	MsgBetweenDates:     {"start", "end"},
+	MsgMaxAge:           {"years"},
+	MsgMinAge:           {"years"},
+	MsgSliceMinLength:   {"min"},
+	MsgSliceMaxLength:   {"max"},
+	MsgSliceLength:      {"length"},
+	MsgSliceMin:         {"index", "min"},
+	MsgSliceMax:         {"index", "max"},
+	MsgSliceBetween:     {"index", "min", "max"},
+	MsgMapMinKeys:       {"min"},
+	MsgMapMaxKeys:       {"max"},
+	MsgRequiredWith:     {"other"},
+	MsgRequiredWithout:  {"other"},
+	MsgEqualTo:          {"other"},
+	MsgNotEqualTo:       {"other"},
+	MsgGreaterThanField: {"other"},
+	MsgLessThanField:    {"other"},
+	MsgAfterField:       {"other"},
+	MsgBeforeField:      {"other"},
+}
+
 type MessageParams map[string]interface{}
 
+// Translator renders messages. The locale is passed on every call, so
+// translators hold no per-request state and can be shared across goroutines
 type Translator interface {
 	Translate(locale Locale, key MessageKey, params MessageParams) string
-	SetLocale(locale Locale)
-	GetLocale() Locale
 }
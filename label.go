@@ -0,0 +1,129 @@
+package valid
+
+import "strings"
+
+// LabelKey returns the catalog key of the label of a field, e.g. "field.tax_id",
+// so labels can be translated along with the messages:
+//
+//	{"field.tax_id": "El RUC", "required": "{field} es requerido"}
+func LabelKey(field string) MessageKey {
+	return MessageKey("field." + field)
+}
+
+// Label sets the name of a field per locale, rendered by the {field}
+// placeholder of the messages. The field is relative to the validator's scope,
+// and labels set here take precedence over the ones of the catalogs. Errors
+// keep the labels set before them, which ValidationErrors.Localize uses too
+func (v *Validator) Label(field string, labels map[Locale]string) *Validator {
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
+
+	// The labels are copied rather than updated, as errors share them
+	path := joinPath(v.path, field)
+	updated := make(map[string]map[Locale]string, len(v.shared.labels)+1)
+	for name, locales := range v.shared.labels {
+		updated[name] = locales
+	}
+
+	locales := make(map[Locale]string, len(updated[path])+len(labels))
+	for locale, label := range updated[path] {
+		locales[locale] = label
+	}
+	for locale, label := range labels {
+		locales[ParseLocale(string(locale))] = label
+	}
+	updated[path] = locales
+	v.shared.labels = updated
+
+	return v
+}
+
+// fieldLabel returns the label of the field at path in the given locale.
+// Labels are looked up by the full path (items[2].name), the path without
+// indexes (items.name) and the last field name (name), first in labels and then
+// in the translator's catalogs. The path itself is used when no label is found
+func fieldLabel(translator Translator, labels map[string]map[Locale]string, locale Locale, path string) string {
+	if label, ok := lookupLabel(translator, labels, locale, path); ok {
+		return label
+	}
+
+	return path
+}
+
+// lookupLabel returns the label of the field at path, reporting whether one
+// was found
+func lookupLabel(translator Translator, labels map[string]map[Locale]string, locale Locale, path string) (string, bool) {
+	for _, name := range labelNames(path) {
+		for _, loc := range locale.Fallbacks(LocaleEN) {
+			if label, ok := labels[name][loc]; ok {
+				return label, true
+			}
+		}
+
+		key := LabelKey(name)
+		if label := translator.Translate(locale, key, nil); label != string(key) {
+			return label, true
+		}
+	}
+
+	return "", false
+}
+
+// labelNames returns the names the label of path is looked up by, most
+// specific first
+func labelNames(path string) []string {
+	names := []string{path}
+
+	var b strings.Builder
+	depth := 0
+	for _, r := range path {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+
+	normalized := b.String()
+	if normalized != path && normalized != "" {
+		names = append(names, normalized)
+	}
+
+	if i := strings.LastIndex(normalized, "."); i >= 0 {
+		names = append(names, normalized[i+1:])
+	}
+
+	return names
+}
+
+// withLabels returns params with the label of the field at path added and
+// the other field of cross-field rules replaced by its label, leaving params
+// untouched so labels are not stored with the error. The other field is a
+// sibling of the field, so it is looked up in the same scope. A field param
+// set by the rule is kept, and so is an other field without a label
+func withLabels(translator Translator, labels map[string]map[Locale]string, locale Locale, path string, params MessageParams) MessageParams {
+	merged := make(MessageParams, len(params)+1)
+	for name, value := range params {
+		merged[name] = value
+	}
+
+	if _, ok := params["field"]; !ok {
+		merged["field"] = fieldLabel(translator, labels, locale, path)
+	}
+
+	if other, ok := params["other"].(string); ok && other != "" {
+		sibling := other
+		if i := strings.LastIndex(path, "."); i > strings.LastIndex(path, "]") {
+			sibling = joinPath(path[:i], other)
+		}
+
+		if label, ok := lookupLabel(translator, labels, locale, sibling); ok {
+			merged["other"] = label
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,88 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLabel(t *testing.T) {
+	translator := NewTranslator(Catalog{
+		LocaleES: {
+			MsgRequired:        "{field} es requerido",
+			LabelKey("email"):  "El correo",
+			LabelKey("street"): "La calle",
+			LabelKey("ruc"):    "el RUC de la empresa",
+		},
+	})
+
+	v := New(WithTranslator(translator), WithLocale(LocaleESPE))
+	v.Label("tax_id", map[Locale]string{LocaleES: "El RUC"})
+	v.Scope("items").Label("name", map[Locale]string{"es": "El nombre del ítem"})
+	v.Scope("account").Label("password", map[Locale]string{LocaleES: "la contraseña"})
+
+	v.String("tax_id", "", StringRules().Required().Build()...)
+	v.String("email", "", StringRules().Required().Build()...)
+	v.Scope("items").Index(2).String("name", "", StringRules().Required().Build()...)
+	v.Scope("address").String("street", "", StringRules().Required().Build()...)
+	v.String("phone", "", StringRules().Required().Build()...)
+	v.Scope("account").String("confirmation", "a", StringRules().EqualTo("password", "b").Build()...)
+	v.String("tax_id_confirmation", "a", StringRules().EqualTo("ruc", "b").Build()...)
+	v.String("alias", "a", StringRules().NotEqualTo("nickname", "a").Build()...)
+
+	var got []string
+	for _, err := range v.Errors() {
+		got = append(got, err.Message)
+	}
+
+	want := []string{
+		"El RUC es requerido",
+		"El correo es requerido",
+		"El nombre del ítem es requerido",
+		"La calle es requerido",
+		"phone es requerido",
+		"debe coincidir con la contraseña",
+		"debe coincidir con el RUC de la empresa",
+		"debe ser distinto de nickname",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("messages = %q, want %q", got, want)
+	}
+
+	// Labels only change the messages, the params keep the field names
+	if other := v.Errors()[5].Params["other"]; other != "password" {
+		t.Errorf("other param = %v, want password", other)
+	}
+
+	localized := v.Errors().Localize(translator, LocaleES)
+	if got := localized[5].Message; got != "debe coincidir con la contraseña" {
+		t.Errorf("localized message = %q", got)
+	}
+}
+
+func TestLabelFieldParam(t *testing.T) {
+	translator := NewTranslator(Catalog{"en": {"taken": "{field} is taken"}})
+
+	v := New(WithTranslator(translator), WithLocale(LocaleEN))
+	v.AddError("user", "taken", MessageParams{"field": "The username"})
+
+	if got := v.Errors()[0].Message; got != "The username is taken" {
+		t.Errorf("message = %q, want the field param set by the rule", got)
+	}
+}
+
+func TestLabelNames(t *testing.T) {
+	tests := map[string][]string{
+		"name":                {"name"},
+		"items[2].name":       {"items[2].name", "items.name", "name"},
+		"address.street":      {"address.street", "street"},
+		"labels[a.b]":         {"labels[a.b]", "labels"},
+		"orders[1].items[3]":  {"orders[1].items[3]", "orders.items", "items"},
+		"matrix[1][2].weight": {"matrix[1][2].weight", "matrix.weight", "weight"},
+	}
+
+	for path, want := range tests {
+		if got := labelNames(path); !reflect.DeepEqual(got, want) {
+			t.Errorf("labelNames(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package valid
+
+import "strings"
+
+// Regional variants. Their messages fall back to the base language, so a
+// regional catalog only needs the keys whose wording differs
+const (
+	LocaleESPE Locale = "es-PE"
+	LocaleESMX Locale = "es-MX"
+	LocaleESCO Locale = "es-CO"
+	LocaleESCL Locale = "es-CL"
+	LocaleESAR Locale = "es-AR"
+	LocalePTBR Locale = "pt-BR"
+	LocalePTPT Locale = "pt-PT"
+	LocaleENUS Locale = "en-US"
+	LocaleENGB Locale = "en-GB"
+)
+
+// ParseLocale normalizes a BCP 47 language tag, so "es_pe" and "ES-pe" both
+// become "es-PE": the language is lowercased, a script is titlecased (zh-Hant)
+// and a region is uppercased
+func ParseLocale(tag string) Locale {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	for i, part := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(part)
+		case len(part) == 4:
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		case len(part) == 2 || len(part) == 3 && part[0] >= '0' && part[0] <= '9':
+			parts[i] = strings.ToUpper(part)
+		default:
+			parts[i] = strings.ToLower(part)
+		}
+	}
+
+	return Locale(strings.Join(parts, "-"))
+}
+
+// Base returns the language of the locale, e.g. "es" for "es-PE"
+func (l Locale) Base() Locale {
+	base, _, _ := strings.Cut(string(l), "-")
+	return Locale(base)
+}
+
+// Parent returns the locale without its last subtag, e.g. "es" for "es-PE", or
+// an empty locale for a bare language
+func (l Locale) Parent() Locale {
+	i := strings.LastIndex(string(l), "-")
+	if i < 0 {
+		return ""
+	}
+
+	return l[:i]
+}
+
+// Fallbacks returns the locales to look messages up in, in order: the locale
+// itself, its parents and then fallback, e.g. es-PE -> es -> en
+func (l Locale) Fallbacks(fallback Locale) []Locale {
+	var chain []Locale
+	for loc := ParseLocale(string(l)); loc != ""; loc = loc.Parent() {
+		chain = append(chain, loc)
+	}
+
+	for loc := fallback; loc != ""; loc = loc.Parent() {
+		if !containsLocale(chain, loc) {
+			chain = append(chain, loc)
+		}
+	}
+
+	return chain
+}
+
+func containsLocale(locales []Locale, locale Locale) bool {
+	for _, l := range locales {
+		if l == locale {
+			return true
+		}
+	}
+
+	return false
+}
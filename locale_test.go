@@ -0,0 +1,82 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := map[string]Locale{
+		"es":          "es",
+		"es_pe":       "es-PE",
+		"ES-pe":       "es-PE",
+		" zh-hant-tw": "zh-Hant-TW",
+		"es-419":      "es-419",
+	}
+
+	for tag, want := range tests {
+		if got := ParseLocale(tag); got != want {
+			t.Errorf("ParseLocale(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
+
+func TestLocaleParents(t *testing.T) {
+	if got := Locale("zh-Hant-TW").Base(); got != "zh" {
+		t.Errorf("Base() = %q, want zh", got)
+	}
+
+	if got := Locale("zh-Hant-TW").Parent(); got != "zh-Hant" {
+		t.Errorf("Parent() = %q, want zh-Hant", got)
+	}
+
+	if got := LocaleES.Parent(); got != "" {
+		t.Errorf("Parent() of a bare language = %q, want empty", got)
+	}
+}
+
+func TestLocaleFallbacks(t *testing.T) {
+	tests := []struct {
+		locale   Locale
+		fallback Locale
+		want     []Locale
+	}{
+		{"es_pe", LocaleEN, []Locale{"es-PE", "es", "en"}},
+		{LocaleEN, LocaleEN, []Locale{"en"}},
+		{LocaleENGB, LocaleENUS, []Locale{"en-GB", "en", "en-US"}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.locale.Fallbacks(tt.fallback); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s.Fallbacks(%s) = %q, want %q", tt.locale, tt.fallback, got, tt.want)
+		}
+	}
+}
+
+func TestRegionalMessages(t *testing.T) {
+	translator := NewTranslator(Catalog{LocaleESPE: {MsgRequired: "es obligatorio, pe"}})
+
+	tests := []struct {
+		locale Locale
+		key    MessageKey
+		want   string
+	}{
+		{LocaleESPE, MsgRequired, "es obligatorio, pe"},
+		{LocaleESPE, MsgEmail, translator.Translate(LocaleES, MsgEmail, nil)},
+		{LocaleESMX, MsgRequired, translator.Translate(LocaleES, MsgRequired, nil)},
+		{"xx", MsgRequired, translator.Translate(LocaleEN, MsgRequired, nil)},
+	}
+
+	for _, tt := range tests {
+		if got := translator.Translate(tt.locale, tt.key, nil); got != tt.want {
+			t.Errorf("Translate(%s, %s) = %q, want %q", tt.locale, tt.key, got, tt.want)
+		}
+	}
+
+	v := New(WithTranslator(translator), WithLocale("es_pe"))
+	v.String("name", "", StringRules().Required().Build()...)
+
+	if got := v.Errors()[0].Message; got != "es obligatorio, pe" {
+		t.Errorf("message = %q, want the es-PE message", got)
+	}
+}
@@ -0,0 +1,56 @@
+package valid
+
+import "testing"
+
+func TestLocalize(t *testing.T) {
+	v := New(WithLocale(LocaleES))
+	v.Label("tax_id", map[Locale]string{LocaleES: "El RUC", LocaleEN: "The tax ID"})
+	v.String("tax_id", "", StringRules().Required().Build()...)
+	v.Int("age", 10, NumberRules[int64]().Min(18).Build()...)
+
+	// Labels set after an error do not change it
+	v.Label("age", map[Locale]string{LocaleEN: "Age"})
+
+	errs := v.Errors()
+	translator := NewTranslator(Catalog{LocaleEN: {
+		MsgRequired: "{field} is required",
+		MsgMinValue: "{field} must be at least {min}",
+		"field.age": "Years",
+	}})
+
+	localized := errs.Localize(translator, LocaleEN)
+	want := []string{"The tax ID is required", "Years must be at least 18"}
+	for i, err := range localized {
+		if err.Message != want[i] {
+			t.Errorf("message %d = %q, want %q", i, err.Message, want[i])
+		}
+
+		if err.Field != errs[i].Field || err.MessageKey != errs[i].MessageKey {
+			t.Errorf("Localize changed the error %d: %+v", i, err)
+		}
+	}
+
+	if errs[0].Message == localized[0].Message {
+		t.Error("Localize modified the original errors")
+	}
+
+	if _, ok := errs[1].Params["field"]; ok {
+		t.Error("the field label is stored in the params")
+	}
+}
+
+func TestLocalizeKeepsParams(t *testing.T) {
+	v := New(WithLocale(LocaleEN))
+	v.String("name", "ab", StringRules().MinLength(3).Build()...)
+
+	errs := v.Errors()
+	es := errs.Localize(NewTranslator(), LocaleES)
+
+	if want := NewTranslator().Translate(LocaleES, MsgMinLength, MessageParams{"min": 3, "field": "name"}); es[0].Message != want {
+		t.Errorf("message = %q, want %q", es[0].Message, want)
+	}
+
+	if es[0].Params["min"] != 3 {
+		t.Errorf("params = %v, want min 3", es[0].Params)
+	}
+}
@@ -0,0 +1,200 @@
+package valid
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MapValidator handles map validation. Errors about an entry are reported as
+// field[key], and errors about a key itself as field.keys[key], so both can be
+// told apart when a key and its value fail.
+//
+// EachKey and Each validate the keys and values of any map type, while
+// StringMap and Int64Map add rule-based Keys and Values on top of them
+type MapValidator[K comparable, V any] struct {
+	v     *Validator
+	field string
+	value map[K]V
+}
+
+func NewMapValidator[K comparable, V any](v *Validator, field string, value map[K]V) *MapValidator[K, V] {
+	return &MapValidator[K, V]{
+		v:     v,
+		field: field,
+		value: value,
+	}
+}
+
+// StringMap creates a new map validator for string to string maps
+func (v *Validator) StringMap(field string, value map[string]string) *StringMapValidator {
+	return NewStringMapValidator(v, field, value)
+}
+
+// Int64Map creates a new map validator for string to int64 maps
+func (v *Validator) Int64Map(field string, value map[string]int64) *Int64MapValidator {
+	return NewInt64MapValidator(v, field, value)
+}
+
+// Required validates that the map is not empty
+func (mv *MapValidator[K, V]) Required() *MapValidator[K, V] {
+	if len(mv.value) == 0 {
+		mv.v.AddError(mv.field, MsgMapRequired, nil)
+	}
+
+	return mv
+}
+
+// MinKeys validates the minimum number of keys
+func (mv *MapValidator[K, V]) MinKeys(min int) *MapValidator[K, V] {
+	if len(mv.value) < min {
+		mv.v.AddError(mv.field, MsgMapMinKeys, MessageParams{
+			"min": min,
+		})
+	}
+
+	return mv
+}
+
+// MaxKeys validates the maximum number of keys
+func (mv *MapValidator[K, V]) MaxKeys(max int) *MapValidator[K, V] {
+	if len(mv.value) > max {
+		mv.v.AddError(mv.field, MsgMapMaxKeys, MessageParams{
+			"max": max,
+		})
+	}
+
+	return mv
+}
+
+// RequiredKeys validates that every given key is present. Missing keys are
+// reported as field[key]
+func (mv *MapValidator[K, V]) RequiredKeys(keys ...K) *MapValidator[K, V] {
+	for _, key := range keys {
+		if _, ok := mv.value[key]; !ok {
+			mv.v.AddError(mv.keyField(key), MsgRequired, nil)
+		}
+	}
+
+	return mv
+}
+
+// AllowedKeys validates that the map has no keys other than the given ones.
+// Unknown keys are reported as field.keys[key]
+func (mv *MapValidator[K, V]) AllowedKeys(keys ...K) *MapValidator[K, V] {
+	allowed := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		allowed[key] = struct{}{}
+	}
+
+	for _, key := range mv.sortedKeys() {
+		if _, ok := allowed[key]; !ok {
+			mv.v.AddError(mv.keyPath(key), MsgMapKeyNotAllowed, nil)
+		}
+	}
+
+	return mv
+}
+
+// Each applies a validation function to each entry in key order. The validator
+// passed to fn is scoped to the entry, so its fields are reported as field[key].name
+func (mv *MapValidator[K, V]) Each(fn func(*Validator, K, V)) *MapValidator[K, V] {
+	scoped := mv.v.Scope(mv.field)
+	for _, key := range mv.sortedKeys() {
+		fn(scoped.Scope(fmt.Sprintf("[%v]", key)), key, mv.value[key])
+	}
+
+	return mv
+}
+
+// EachKey applies a validation function to each key in order. The validator
+// passed to fn is scoped to the key, so it is reported as field.keys[key]
+func (mv *MapValidator[K, V]) EachKey(fn func(*Validator, K)) *MapValidator[K, V] {
+	for _, key := range mv.sortedKeys() {
+		fn(mv.v.Scope(mv.keyPath(key)), key)
+	}
+
+	return mv
+}
+
+// keyField returns the field name of the entry with the given key
+func (mv *MapValidator[K, V]) keyField(key K) string {
+	return fmt.Sprintf("%s[%v]", mv.field, key)
+}
+
+// keyPath returns the field name of the given key itself
+func (mv *MapValidator[K, V]) keyPath(key K) string {
+	return fmt.Sprintf("%s[%v]", joinPath(mv.field, "keys"), key)
+}
+
+// sortedKeys returns the map keys in a stable order, so errors are reported
+// deterministically
+func (mv *MapValidator[K, V]) sortedKeys() []K {
+	keys := make([]K, 0, len(mv.value))
+	for key := range mv.value {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+
+	return keys
+}
+
+// StringMapValidator handles map[string]string validation
+type StringMapValidator struct {
+	*MapValidator[string, string]
+}
+
+// Int64MapValidator handles map[string]int64 validation
+type Int64MapValidator struct {
+	*MapValidator[string, int64]
+}
+
+func NewStringMapValidator(v *Validator, field string, value map[string]string) *StringMapValidator {
+	return &StringMapValidator{
+		MapValidator: NewMapValidator(v, field, value),
+	}
+}
+
+func NewInt64MapValidator(v *Validator, field string, value map[string]int64) *Int64MapValidator {
+	return &Int64MapValidator{
+		MapValidator: NewMapValidator(v, field, value),
+	}
+}
+
+// Keys applies string rules to every key, reporting errors as field.keys[key]
+func (mv *StringMapValidator) Keys(opts ...StringOption) *StringMapValidator {
+	mv.EachKey(func(v *Validator, key string) {
+		v.String("", key, opts...)
+	})
+
+	return mv
+}
+
+// Values applies string rules to every value, reporting errors as field[key]
+func (mv *StringMapValidator) Values(opts ...StringOption) *StringMapValidator {
+	mv.Each(func(v *Validator, _ string, value string) {
+		v.String("", value, opts...)
+	})
+
+	return mv
+}
+
+// Keys applies string rules to every key, reporting errors as field.keys[key]
+func (mv *Int64MapValidator) Keys(opts ...StringOption) *Int64MapValidator {
+	mv.EachKey(func(v *Validator, key string) {
+		v.String("", key, opts...)
+	})
+
+	return mv
+}
+
+// Values applies number rules to every value, reporting errors as field[key]
+func (mv *Int64MapValidator) Values(opts ...NumberOption[int64]) *Int64MapValidator {
+	mv.Each(func(v *Validator, _ string, value int64) {
+		v.Int("", value, opts...)
+	})
+
+	return mv
+}
@@ -0,0 +1,67 @@
+package valid
+
+import "testing"
+
+func TestStringMap(t *testing.T) {
+	v := New()
+	v.StringMap("labels", map[string]string{"env": "", "region": ""}).
+		Keys(StringRules().MaxLength(3).Build()...).
+		Values(StringRules().Required().Build()...).
+		RequiredKeys("env", "team").
+		AllowedKeys("env", "team")
+
+	// A key and its value failing are reported under different paths
+	assertErrors(t, v,
+		"labels.keys[region]:max_length",
+		"labels[env]:required",
+		"labels[region]:required",
+		"labels[team]:required",
+		"labels.keys[region]:map_key_not_allowed",
+	)
+}
+
+func TestScopedMapWithoutField(t *testing.T) {
+	v := New()
+	v.Scope("meta").StringMap("", map[string]string{"Bad": "x", "env": ""}).
+		Keys(StringRules().MaxLength(2).Build()...).
+		Values(StringRules().Required().Build()...).
+		AllowedKeys("env")
+
+	assertErrors(t, v,
+		"meta.keys[Bad]:max_length",
+		"meta.keys[env]:max_length",
+		"meta[env]:required",
+		"meta.keys[Bad]:map_key_not_allowed",
+	)
+}
+
+func TestInt64Map(t *testing.T) {
+	v := New()
+	v.Int64Map("limits", map[string]int64{"cpu": 0, "": 2}).
+		Keys(StringRules().Required().Build()...).
+		Values(NumberRules[int64]().Min(1).Build()...).
+		MaxKeys(1)
+
+	assertErrors(t, v, "limits.keys[]:required", "limits[cpu]:min_value", "limits:map_max_keys")
+}
+
+func TestMapValidator(t *testing.T) {
+	type limit struct{ Max int64 }
+
+	v := New()
+	NewMapValidator(v, "limits", map[int]limit{2: {Max: 0}, 10: {Max: 1}}).
+		MinKeys(3).
+		EachKey(func(v *Validator, key int) {
+			v.Int("", int64(key), NumberRules[int64]().Max(5).Build()...)
+		}).
+		Each(func(v *Validator, key int, l limit) {
+			v.Int("max", l.Max, NumberRules[int64]().Min(1).Build()...)
+		})
+
+	assertErrors(t, v, "limits:map_min_keys", "limits.keys[10]:max_value", "limits[2].max:min_value")
+
+	v = New()
+	NewMapValidator(v, "limits", map[string]int(nil)).Required()
+
+	assertErrors(t, v, "limits:map_required")
+}
@@ -0,0 +1,264 @@
+package valid
+
+// defaultMessages holds the built-in message templates per locale. Params are
+// referenced by name, e.g. {min}
+var defaultMessages = Catalog{
+	LocaleES: {
+		MsgRequired:         "el campo es requerido",
+		MsgMinLength:        "la longitud mínima es de {min, plural, one {# carácter} other {# caracteres}}",
+		MsgMaxLength:        "la longitud máxima es de {max, plural, one {# carácter} other {# caracteres}}",
+		MsgEmail:            "formato de correo electrónico inválido",
+		MsgMinValue:         "debe ser mayor o igual a {min}",
+		MsgMaxValue:         "debe ser menor o igual a {max}",
+		MsgBetween:          "debe estar entre {min} y {max}",
+		MsgPrecision:        "debe tener máximo {decimals, plural, one {# decimal} other {# decimales}}",
+		MsgPast:             "debe estar en el pasado",
+		MsgFuture:           "debe estar en el futuro",
+		MsgAfter:            "debe ser posterior a {date}",
+		MsgBefore:           "debe ser anterior a {date}",
+		MsgBetweenDates:     "debe estar entre {start} y {end}",
+		MsgWeekday:          "debe ser un día válido de la semana",
+		MsgMaxAge:           "la edad no puede exceder {years, plural, one {# año} other {# años}}",
+		MsgMinAge:           "la edad debe ser al menos {years, plural, one {# año} other {# años}}",
+		MsgSliceRequired:    "el campo es requerido",
+		MsgSliceMinLength:   "debe tener al menos {min, plural, one {# elemento} other {# elementos}}",
+		MsgSliceMaxLength:   "debe tener máximo {max, plural, one {# elemento} other {# elementos}}",
+		MsgSliceLength:      "debe tener exactamente {length, plural, one {# elemento} other {# elementos}}",
+		MsgSliceMin:         "el elemento en la posición {index} debe ser mayor o igual a {min}",
+		MsgSliceMax:         "el elemento en la posición {index} debe ser menor o igual a {max}",
+		MsgSliceBetween:     "el elemento en la posición {index} debe estar entre {min} y {max}",
+		MsgInvalidUUID:      "UUID inválido",
+		MsgOneOf:            "debe ser uno de los valores permitidos",
+		MsgMapRequired:      "el campo es requerido",
+		MsgMapMinKeys:       "debe tener al menos {min, plural, one {# clave} other {# claves}}",
+		MsgMapMaxKeys:       "debe tener máximo {max, plural, one {# clave} other {# claves}}",
+		MsgMapKeyNotAllowed: "la clave no está permitida",
+		MsgNotNil:           "el campo debe estar presente",
+		MsgRequiredIf:       "el campo es requerido",
+		MsgRequiredWith:     "el campo es requerido cuando {other} está presente",
+		MsgRequiredWithout:  "el campo es requerido cuando {other} no está presente",
+		MsgEqualTo:          "debe coincidir con {other}",
+		MsgNotEqualTo:       "debe ser distinto de {other}",
+		MsgGreaterThanField: "debe ser mayor que {other}",
+		MsgLessThanField:    "debe ser menor que {other}",
+		MsgAfterField:       "debe ser posterior a {other}",
+		MsgBeforeField:      "debe ser anterior a {other}",
+		MsgAlreadyExists:    "el valor ya existe",
+		MsgNotFound:         "el valor no existe",
+	},
+	LocaleEN: {
+		MsgRequired:         "field is required",
+		MsgMinLength:        "minimum length is {min, plural, one {# character} other {# characters}}",
+		MsgMaxLength:        "maximum length is {max, plural, one {# character} other {# characters}}",
+		MsgEmail:            "invalid email format",
+		MsgMinValue:         "must be greater than or equal to {min}",
+		MsgMaxValue:         "must be less than or equal to {max}",
+		MsgBetween:          "must be between {min} and {max}",
+		MsgPrecision:        "must have maximum {decimals, plural, one {# decimal place} other {# decimal places}}",
+		MsgPast:             "must be in the past",
+		MsgFuture:           "must be in the future",
+		MsgAfter:            "must be after {date}",
+		MsgBefore:           "must be before {date}",
+		MsgBetweenDates:     "must be between {start} and {end}",
+		MsgWeekday:          "must be on a valid weekday",
+		MsgMaxAge:           "age cannot exceed {years, plural, one {# year} other {# years}}",
+		MsgMinAge:           "age must be at least {years, plural, one {# year} other {# years}}",
+		MsgSliceRequired:    "field is required",
+		MsgSliceMinLength:   "must have at least {min, plural, one {# element} other {# elements}}",
+		MsgSliceMaxLength:   "must have maximum {max, plural, one {# element} other {# elements}}",
+		MsgSliceLength:      "must have exactly {length, plural, one {# element} other {# elements}}",
+		MsgSliceMin:         "element at position {index} must be greater than or equal to {min}",
+		MsgSliceMax:         "element at position {index} must be less than or equal to {max}",
+		MsgSliceBetween:     "element at position {index} must be between {min} and {max}",
+		MsgInvalidUUID:      "invalid uuid",
+		MsgOneOf:            "must be one of the allowed values",
+		MsgMapRequired:      "field is required",
+		MsgMapMinKeys:       "must have at least {min, plural, one {# key} other {# keys}}",
+		MsgMapMaxKeys:       "must have maximum {max, plural, one {# key} other {# keys}}",
+		MsgMapKeyNotAllowed: "key is not allowed",
+		MsgNotNil:           "field must be present",
+		MsgRequiredIf:       "field is required",
+		MsgRequiredWith:     "field is required when {other} is present",
+		MsgRequiredWithout:  "field is required when {other} is not present",
+		MsgEqualTo:          "must match {other}",
+		MsgNotEqualTo:       "must be different from {other}",
+		MsgGreaterThanField: "must be greater than {other}",
+		MsgLessThanField:    "must be less than {other}",
+		MsgAfterField:       "must be after {other}",
+		MsgBeforeField:      "must be before {other}",
+		MsgAlreadyExists:    "value already exists",
+		MsgNotFound:         "value does not exist",
+	},
+	LocalePT: {
+		MsgRequired:         "o campo é obrigatório",
+		MsgMinLength:        "o comprimento mínimo é de {min, plural, one {# caractere} other {# caracteres}}",
+		MsgMaxLength:        "o comprimento máximo é de {max, plural, one {# caractere} other {# caracteres}}",
+		MsgEmail:            "formato de e-mail inválido",
+		MsgMinValue:         "deve ser maior ou igual a {min}",
+		MsgMaxValue:         "deve ser menor ou igual a {max}",
+		MsgBetween:          "deve estar entre {min} e {max}",
+		MsgPrecision:        "deve ter no máximo {decimals, plural, one {# casa decimal} other {# casas decimais}}",
+		MsgPast:             "deve estar no passado",
+		MsgFuture:           "deve estar no futuro",
+		MsgAfter:            "deve ser posterior a {date}",
+		MsgBefore:           "deve ser anterior a {date}",
+		MsgBetweenDates:     "deve estar entre {start} e {end}",
+		MsgWeekday:          "deve ser um dia da semana permitido",
+		MsgMaxAge:           "a idade não pode exceder {years, plural, one {# ano} other {# anos}}",
+		MsgMinAge:           "a idade deve ser de pelo menos {years, plural, one {# ano} other {# anos}}",
+		MsgSliceRequired:    "o campo é obrigatório",
+		MsgSliceMinLength:   "deve ter pelo menos {min, plural, one {# elemento} other {# elementos}}",
+		MsgSliceMaxLength:   "deve ter no máximo {max, plural, one {# elemento} other {# elementos}}",
+		MsgSliceLength:      "deve ter exatamente {length, plural, one {# elemento} other {# elementos}}",
+		MsgSliceMin:         "o elemento na posição {index} deve ser maior ou igual a {min}",
+		MsgSliceMax:         "o elemento na posição {index} deve ser menor ou igual a {max}",
+		MsgSliceBetween:     "o elemento na posição {index} deve estar entre {min} e {max}",
+		MsgInvalidUUID:      "UUID inválido",
+		MsgOneOf:            "deve ser um dos valores permitidos",
+		MsgMapRequired:      "o campo é obrigatório",
+		MsgMapMinKeys:       "deve ter pelo menos {min, plural, one {# chave} other {# chaves}}",
+		MsgMapMaxKeys:       "deve ter no máximo {max, plural, one {# chave} other {# chaves}}",
+		MsgMapKeyNotAllowed: "a chave não é permitida",
+		MsgNotNil:           "o campo deve estar presente",
+		MsgRequiredIf:       "o campo é obrigatório",
+		MsgRequiredWith:     "o campo é obrigatório quando {other} está presente",
+		MsgRequiredWithout:  "o campo é obrigatório quando {other} não está presente",
+		MsgEqualTo:          "deve coincidir com {other}",
+		MsgNotEqualTo:       "deve ser diferente de {other}",
+		MsgGreaterThanField: "deve ser maior que {other}",
+		MsgLessThanField:    "deve ser menor que {other}",
+		MsgAfterField:       "deve ser posterior a {other}",
+		MsgBeforeField:      "deve ser anterior a {other}",
+		MsgAlreadyExists:    "o valor já existe",
+		MsgNotFound:         "o valor não existe",
+	},
+	LocaleFR: {
+		MsgRequired:         "le champ est obligatoire",
+		MsgMinLength:        "la longueur minimale est de {min, plural, one {# caractère} other {# caractères}}",
+		MsgMaxLength:        "la longueur maximale est de {max, plural, one {# caractère} other {# caractères}}",
+		MsgEmail:            "format d'adresse e-mail invalide",
+		MsgMinValue:         "doit être supérieur ou égal à {min}",
+		MsgMaxValue:         "doit être inférieur ou égal à {max}",
+		MsgBetween:          "doit être compris entre {min} et {max}",
+		MsgPrecision:        "doit avoir au maximum {decimals, plural, one {# décimale} other {# décimales}}",
+		MsgPast:             "doit être dans le passé",
+		MsgFuture:           "doit être dans le futur",
+		MsgAfter:            "doit être postérieur à {date}",
+		MsgBefore:           "doit être antérieur à {date}",
+		MsgBetweenDates:     "doit être compris entre {start} et {end}",
+		MsgWeekday:          "doit être un jour de la semaine autorisé",
+		MsgMaxAge:           "l'âge ne peut pas dépasser {years, plural, one {# an} other {# ans}}",
+		MsgMinAge:           "l'âge doit être d'au moins {years, plural, one {# an} other {# ans}}",
+		MsgSliceRequired:    "le champ est obligatoire",
+		MsgSliceMinLength:   "doit contenir au moins {min, plural, one {# élément} other {# éléments}}",
+		MsgSliceMaxLength:   "doit contenir au maximum {max, plural, one {# élément} other {# éléments}}",
+		MsgSliceLength:      "doit contenir exactement {length, plural, one {# élément} other {# éléments}}",
+		MsgSliceMin:         "l'élément à la position {index} doit être supérieur ou égal à {min}",
+		MsgSliceMax:         "l'élément à la position {index} doit être inférieur ou égal à {max}",
+		MsgSliceBetween:     "l'élément à la position {index} doit être compris entre {min} et {max}",
+		MsgInvalidUUID:      "UUID invalide",
+		MsgOneOf:            "doit être l'une des valeurs autorisées",
+		MsgMapRequired:      "le champ est obligatoire",
+		MsgMapMinKeys:       "doit contenir au moins {min, plural, one {# clé} other {# clés}}",
+		MsgMapMaxKeys:       "doit contenir au maximum {max, plural, one {# clé} other {# clés}}",
+		MsgMapKeyNotAllowed: "la clé n'est pas autorisée",
+		MsgNotNil:           "le champ doit être présent",
+		MsgRequiredIf:       "le champ est obligatoire",
+		MsgRequiredWith:     "le champ est obligatoire lorsque {other} est présent",
+		MsgRequiredWithout:  "le champ est obligatoire lorsque {other} est absent",
+		MsgEqualTo:          "doit correspondre à {other}",
+		MsgNotEqualTo:       "doit être différent de {other}",
+		MsgGreaterThanField: "doit être supérieur à {other}",
+		MsgLessThanField:    "doit être inférieur à {other}",
+		MsgAfterField:       "doit être postérieur à {other}",
+		MsgBeforeField:      "doit être antérieur à {other}",
+		MsgAlreadyExists:    "la valeur existe déjà",
+		MsgNotFound:         "la valeur n'existe pas",
+	},
+	LocaleDE: {
+		MsgRequired:         "das Feld ist erforderlich",
+		MsgMinLength:        "die Mindestlänge beträgt {min, plural, one {# Zeichen} other {# Zeichen}}",
+		MsgMaxLength:        "die maximale Länge beträgt {max, plural, one {# Zeichen} other {# Zeichen}}",
+		MsgEmail:            "ungültiges E-Mail-Format",
+		MsgMinValue:         "muss größer oder gleich {min} sein",
+		MsgMaxValue:         "muss kleiner oder gleich {max} sein",
+		MsgBetween:          "muss zwischen {min} und {max} liegen",
+		MsgPrecision:        "darf höchstens {decimals, plural, one {# Nachkommastelle} other {# Nachkommastellen}} haben",
+		MsgPast:             "muss in der Vergangenheit liegen",
+		MsgFuture:           "muss in der Zukunft liegen",
+		MsgAfter:            "muss nach {date} liegen",
+		MsgBefore:           "muss vor {date} liegen",
+		MsgBetweenDates:     "muss zwischen {start} und {end} liegen",
+		MsgWeekday:          "muss ein gültiger Wochentag sein",
+		MsgMaxAge:           "das Alter darf {years, plural, one {# Jahr} other {# Jahre}} nicht überschreiten",
+		MsgMinAge:           "das Alter muss mindestens {years, plural, one {# Jahr} other {# Jahre}} betragen",
+		MsgSliceRequired:    "das Feld ist erforderlich",
+		MsgSliceMinLength:   "muss mindestens {min, plural, one {# Element} other {# Elemente}} enthalten",
+		MsgSliceMaxLength:   "darf höchstens {max, plural, one {# Element} other {# Elemente}} enthalten",
+		MsgSliceLength:      "muss genau {length, plural, one {# Element} other {# Elemente}} enthalten",
+		MsgSliceMin:         "das Element an Position {index} muss größer oder gleich {min} sein",
+		MsgSliceMax:         "das Element an Position {index} muss kleiner oder gleich {max} sein",
+		MsgSliceBetween:     "das Element an Position {index} muss zwischen {min} und {max} liegen",
+		MsgInvalidUUID:      "ungültige UUID",
+		MsgOneOf:            "muss einer der zulässigen Werte sein",
+		MsgMapRequired:      "das Feld ist erforderlich",
+		MsgMapMinKeys:       "muss mindestens {min, plural, one {# Schlüssel} other {# Schlüssel}} enthalten",
+		MsgMapMaxKeys:       "darf höchstens {max, plural, one {# Schlüssel} other {# Schlüssel}} enthalten",
+		MsgMapKeyNotAllowed: "der Schlüssel ist nicht zulässig",
+		MsgNotNil:           "das Feld muss vorhanden sein",
+		MsgRequiredIf:       "das Feld ist erforderlich",
+		MsgRequiredWith:     "das Feld ist erforderlich, wenn {other} vorhanden ist",
+		MsgRequiredWithout:  "das Feld ist erforderlich, wenn {other} nicht vorhanden ist",
+		MsgEqualTo:          "muss mit {other} übereinstimmen",
+		MsgNotEqualTo:       "muss sich von {other} unterscheiden",
+		MsgGreaterThanField: "muss größer als {other} sein",
+		MsgLessThanField:    "muss kleiner als {other} sein",
+		MsgAfterField:       "muss nach {other} liegen",
+		MsgBeforeField:      "muss vor {other} liegen",
+		MsgAlreadyExists:    "der Wert existiert bereits",
+		MsgNotFound:         "der Wert existiert nicht",
+	},
+	LocaleIT: {
+		MsgRequired:         "il campo è obbligatorio",
+		MsgMinLength:        "la lunghezza minima è di {min, plural, one {# carattere} other {# caratteri}}",
+		MsgMaxLength:        "la lunghezza massima è di {max, plural, one {# carattere} other {# caratteri}}",
+		MsgEmail:            "formato email non valido",
+		MsgMinValue:         "deve essere maggiore o uguale a {min}",
+		MsgMaxValue:         "deve essere minore o uguale a {max}",
+		MsgBetween:          "deve essere compreso tra {min} e {max}",
+		MsgPrecision:        "deve avere al massimo {decimals, plural, one {# cifra decimale} other {# cifre decimali}}",
+		MsgPast:             "deve essere nel passato",
+		MsgFuture:           "deve essere nel futuro",
+		MsgAfter:            "deve essere successivo a {date}",
+		MsgBefore:           "deve essere precedente a {date}",
+		MsgBetweenDates:     "deve essere compreso tra {start} e {end}",
+		MsgWeekday:          "deve essere un giorno della settimana consentito",
+		MsgMaxAge:           "l'età non può superare {years, plural, one {# anno} other {# anni}}",
+		MsgMinAge:           "l'età deve essere di almeno {years, plural, one {# anno} other {# anni}}",
+		MsgSliceRequired:    "il campo è obbligatorio",
+		MsgSliceMinLength:   "deve contenere almeno {min, plural, one {# elemento} other {# elementi}}",
+		MsgSliceMaxLength:   "deve contenere al massimo {max, plural, one {# elemento} other {# elementi}}",
+		MsgSliceLength:      "deve contenere esattamente {length, plural, one {# elemento} other {# elementi}}",
+		MsgSliceMin:         "l'elemento in posizione {index} deve essere maggiore o uguale a {min}",
+		MsgSliceMax:         "l'elemento in posizione {index} deve essere minore o uguale a {max}",
+		MsgSliceBetween:     "l'elemento in posizione {index} deve essere compreso tra {min} e {max}",
+		MsgInvalidUUID:      "UUID non valido",
+		MsgOneOf:            "deve essere uno dei valori consentiti",
+		MsgMapRequired:      "il campo è obbligatorio",
+		MsgMapMinKeys:       "deve contenere almeno {min, plural, one {# chiave} other {# chiavi}}",
+		MsgMapMaxKeys:       "deve contenere al massimo {max, plural, one {# chiave} other {# chiavi}}",
+		MsgMapKeyNotAllowed: "la chiave non è consentita",
+		MsgNotNil:           "il campo deve essere presente",
+		MsgRequiredIf:       "il campo è obbligatorio",
+		MsgRequiredWith:     "il campo è obbligatorio quando {other} è presente",
+		MsgRequiredWithout:  "il campo è obbligatorio quando {other} non è presente",
+		MsgEqualTo:          "deve corrispondere a {other}",
+		MsgNotEqualTo:       "deve essere diverso da {other}",
+		MsgGreaterThanField: "deve essere maggiore di {other}",
+		MsgLessThanField:    "deve essere minore di {other}",
+		MsgAfterField:       "deve essere successivo a {other}",
+		MsgBeforeField:      "deve essere precedente a {other}",
+		MsgAlreadyExists:    "il valore esiste già",
+		MsgNotFound:         "il valore non esiste",
+	},
+}
@@ -0,0 +1,77 @@
+package valid
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+)
+
+// messageKeys returns the values of the MessageKey constants declared in
+// i18n.go, so keys added later are checked without updating the test
+func messageKeys(t *testing.T) []MessageKey {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "i18n.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []MessageKey
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if ident, ok := vs.Type.(*ast.Ident); !ok || ident.Name != "MessageKey" {
+				continue
+			}
+
+			for _, value := range vs.Values {
+				key, err := strconv.Unquote(value.(*ast.BasicLit).Value)
+				if err != nil {
+					t.Fatal(err)
+				}
+				keys = append(keys, MessageKey(key))
+			}
+		}
+	}
+
+	if len(keys) == 0 {
+		t.Fatal("no MessageKey constants found in i18n.go")
+	}
+
+	return keys
+}
+
+func TestDefaultMessages(t *testing.T) {
+	keys := messageKeys(t)
+
+	for _, locale := range []Locale{LocaleES, LocaleEN, LocalePT, LocaleFR, LocaleDE, LocaleIT} {
+		msgs, ok := defaultMessages[locale]
+		if !ok {
+			t.Errorf("no built-in messages for %s", locale)
+			continue
+		}
+
+		for _, key := range keys {
+			msg, ok := msgs[key]
+			if !ok {
+				t.Errorf("%s: missing message for %s", locale, key)
+				continue
+			}
+
+			if _, err := parseTemplate(msg); err != nil {
+				t.Errorf("%s: %s: %v", locale, key, err)
+			}
+		}
+
+		if len(msgs) != len(keys) {
+			t.Errorf("%s: %d messages for %d keys", locale, len(msgs), len(keys))
+		}
+	}
+}
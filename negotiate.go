@@ -0,0 +1,152 @@
+package valid
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// localeKey is the context key of the request locale
+type localeKey struct{}
+
+// ContextWithLocale returns a copy of ctx carrying locale
+func ContextWithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext returns the locale set with ContextWithLocale
+func LocaleFromContext(ctx context.Context) (Locale, bool) {
+	locale, ok := ctx.Value(localeKey{}).(Locale)
+	return locale, ok
+}
+
+// NewFromContext creates a validator bound to ctx and configured by the given
+// options. The locale set with ContextWithLocale, if any, takes precedence over
+// the one of the options
+func NewFromContext(ctx context.Context, opts ...Option) *Validator {
+	if locale, ok := LocaleFromContext(ctx); ok {
+		opts = append(opts[:len(opts):len(opts)], WithLocale(locale))
+	}
+
+	return New(opts...).WithContext(ctx)
+}
+
+// LocaleMiddleware stores the locale negotiated by LocaleFromRequest against
+// the given locales in the request context, so handlers can use NewFromContext
+func LocaleMiddleware(next http.Handler, locales ...Locale) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := ContextWithLocale(r.Context(), LocaleFromRequest(r, locales...))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// LocaleFromRequest negotiates the locale of the request's Accept-Language
+// header against the built-in catalogs and the given locales, such as the ones
+// of a catalog loaded with LoadCatalog, defaulting to LocaleES
+func LocaleFromRequest(r *http.Request, locales ...Locale) Locale {
+	available := builtinLocales()
+	for _, locale := range locales {
+		if locale = ParseLocale(string(locale)); !containsLocale(available, locale) {
+			available = append(available, locale)
+		}
+	}
+
+	return NegotiateLocale(r.Header.Get("Accept-Language"), available...)
+}
+
+// NegotiateLocale returns the locale of an Accept-Language header, such as
+// "es-PE,es;q=0.9,en;q=0.8", that best matches the available ones.
+//
+// Tags are tried by decreasing quality. A tag matches when it or one of its
+// parents is available, in which case the tag itself is returned so regional
+// messages are used when present and fall back otherwise; a bare language also
+// matches its regional variants. When nothing matches, the first available
+// locale is returned
+func NegotiateLocale(header string, available ...Locale) Locale {
+	if len(available) == 0 {
+		return ""
+	}
+
+	canonical := make([]Locale, len(available))
+	for i, locale := range available {
+		canonical[i] = ParseLocale(string(locale))
+	}
+
+	for _, tag := range parseAcceptLanguage(header) {
+		if tag == "*" {
+			return canonical[0]
+		}
+
+		locale := ParseLocale(tag)
+		for loc := locale; loc != ""; loc = loc.Parent() {
+			if containsLocale(canonical, loc) {
+				return locale
+			}
+		}
+
+		for _, loc := range canonical {
+			if loc.Base() == locale {
+				return loc
+			}
+		}
+	}
+
+	return canonical[0]
+}
+
+// parseAcceptLanguage returns the tags of an Accept-Language header sorted by
+// decreasing quality, leaving out the ones with q=0
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag = strings.TrimSpace(tag); tag == "" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+
+		if q > 0 {
+			tags = append(tags, weighted{tag: tag, q: q})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+
+	result := make([]string, len(tags))
+	for i, t := range tags {
+		result[i] = t.tag
+	}
+
+	return result
+}
+
+// builtinLocales returns the locales of the built-in catalogs, starting with
+// the default one
+func builtinLocales() []Locale {
+	locales := make([]Locale, 0, len(defaultMessages))
+	for locale := range defaultMessages {
+		if locale != LocaleES {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Slice(locales, func(i, j int) bool { return locales[i] < locales[j] })
+
+	return append([]Locale{LocaleES}, locales...)
+}
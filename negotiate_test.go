@@ -0,0 +1,117 @@
+package valid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+	available := []Locale{LocaleES, LocaleEN, LocalePTBR}
+
+	tests := []struct {
+		header string
+		want   Locale
+	}{
+		{"", LocaleES},
+		{"en", LocaleEN},
+		{"es-PE,es;q=0.9,en;q=0.8", LocaleESPE},
+		{"fr;q=1, en;q=0.5", LocaleEN},
+		{"en;q=0.2, es;q=0.8", LocaleES},
+		{"pt", LocalePTBR},
+		{"de, *;q=0.1", LocaleES},
+		{"en;q=0, fr", LocaleES},
+		{"en-gb", LocaleENGB},
+		{"en;q=abc, pt-br", LocalePTBR},
+	}
+
+	for _, tt := range tests {
+		if got := NegotiateLocale(tt.header, available...); got != tt.want {
+			t.Errorf("NegotiateLocale(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+
+	if got := NegotiateLocale("en"); got != "" {
+		t.Errorf("NegotiateLocale without locales = %q, want empty", got)
+	}
+}
+
+func TestLocaleFromRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/users", nil)
+	r.Header.Set("Accept-Language", "fr-CA,fr;q=0.9")
+
+	if got := LocaleFromRequest(r); got != "fr-CA" {
+		t.Errorf("LocaleFromRequest() = %q, want fr-CA", got)
+	}
+
+	// Locales only found in a loaded catalog can be negotiated too
+	catalog := Catalog{"ja": {MsgRequired: "必須です"}}
+	r.Header.Set("Accept-Language", "ja, en;q=0.5")
+
+	if got := LocaleFromRequest(r); got != LocaleEN {
+		t.Errorf("LocaleFromRequest() = %q, want en without the catalog", got)
+	}
+
+	if got := LocaleFromRequest(r, catalog.Locales()...); got != "ja" {
+		t.Errorf("LocaleFromRequest(ja) = %q, want ja", got)
+	}
+
+	r.Header.Set("Accept-Language", "de")
+	if got := LocaleFromRequest(r, "ja"); got != LocaleDE {
+		t.Errorf("LocaleFromRequest(ja) = %q, want the built-in de", got)
+	}
+}
+
+func TestLocaleMiddleware(t *testing.T) {
+	var got string
+	handler := LocaleMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := NewFromContext(r.Context())
+		v.String("name", "", StringRules().Required().Build()...)
+		got = v.Errors()[0].Message
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/users", nil)
+	r.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	if want := NewTranslator().Translate(LocaleEN, MsgRequired, MessageParams{"field": "name"}); got != want {
+		t.Errorf("message = %q, want %q", got, want)
+	}
+
+	var locale Locale
+	handler = LocaleMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale, _ = LocaleFromContext(r.Context())
+	}), "ja")
+
+	r.Header.Set("Accept-Language", "ja-JP, en;q=0.5")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	if locale != "ja-JP" {
+		t.Errorf("locale = %q, want ja-JP", locale)
+	}
+}
+
+func TestNewFromContext(t *testing.T) {
+	ctx := ContextWithLocale(context.Background(), LocaleDE)
+
+	if locale, ok := LocaleFromContext(ctx); !ok || locale != LocaleDE {
+		t.Errorf("LocaleFromContext() = %q, %v", locale, ok)
+	}
+
+	if _, ok := LocaleFromContext(context.Background()); ok {
+		t.Error("LocaleFromContext() found a locale in an empty context")
+	}
+
+	// The context locale takes precedence over the options
+	v := NewFromContext(ctx, WithLocale(LocaleEN))
+	v.String("name", "", StringRules().Required().Build()...)
+
+	if want := NewTranslator().Translate(LocaleDE, MsgRequired, MessageParams{"field": "name"}); v.Errors()[0].Message != want {
+		t.Errorf("message = %q, want %q", v.Errors()[0].Message, want)
+	}
+
+	if v.Context() != ctx {
+		t.Error("NewFromContext does not bind the context")
+	}
+}
@@ -0,0 +1,107 @@
+package valid
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Validatable is implemented by types that validate themselves. The validator
+// passed to ValidateWith is already scoped to the value, so field names are
+// relative to it
+type Validatable interface {
+	ValidateWith(v *Validator)
+}
+
+var validatableType = reflect.TypeOf((*Validatable)(nil)).Elem()
+
+// Nested validates a nested struct, scoping its fields under field.
+//
+// Values implementing Validatable are validated through ValidateWith, any other
+// struct through its `valid` tags. Nil pointers are skipped, and slices, arrays
+// and maps are validated element by element as Dive does
+func (v *Validator) Nested(field string, value any) {
+	v.Scope(field).nested(reflect.ValueOf(value))
+}
+
+// Dive validates every element of a slice, array or map the same way Nested
+// does, reporting fields as field[i].name or field[key].name
+func (v *Validator) Dive(field string, value any) {
+	rv := reflect.Indirect(reflect.ValueOf(value))
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Invalid:
+	default:
+		panic(fmt.Sprintf("valid: Dive expects a slice, array or map, got %s", rv.Kind()))
+	}
+
+	v.Scope(field).nested(rv)
+}
+
+// nested validates rv with the scope of v
+func (v *Validator) nested(rv reflect.Value) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return
+		}
+
+		if val, ok := asValidatable(rv); ok {
+			val.ValidateWith(v)
+			return
+		}
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() {
+		return
+	}
+
+	if val, ok := asValidatable(rv); ok {
+		val.ValidateWith(v)
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		if rv.Type() != timeType {
+			v.structValue(rv)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			v.Index(i).nested(rv.Index(i))
+		}
+	case reflect.Map:
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+
+		for _, key := range keys {
+			v.Scope(fmt.Sprintf("[%v]", key)).nested(rv.MapIndex(key))
+		}
+	}
+}
+
+// asValidatable returns rv as a Validatable, taking its address when only the
+// pointer implements the interface
+func asValidatable(rv reflect.Value) (Validatable, bool) {
+	if !rv.CanInterface() {
+		return nil, false
+	}
+
+	if val, ok := rv.Interface().(Validatable); ok {
+		return val, true
+	}
+
+	if rv.Kind() == reflect.Pointer || !reflect.PointerTo(rv.Type()).Implements(validatableType) {
+		return nil, false
+	}
+
+	if rv.CanAddr() {
+		return rv.Addr().Interface().(Validatable), true
+	}
+
+	ptr := reflect.New(rv.Type())
+	ptr.Elem().Set(rv)
+
+	return ptr.Interface().(Validatable), true
+}
@@ -0,0 +1,80 @@
+package valid
+
+import (
+	"testing"
+	"time"
+)
+
+type nestedLine struct {
+	SKU      string
+	Quantity int64
+}
+
+func (l nestedLine) ValidateWith(v *Validator) {
+	v.String("sku", l.SKU, StringRules().Required().Build()...)
+	v.Int("quantity", l.Quantity, NumberRules[int64]().Min(1).Build()...)
+}
+
+type nestedAddress struct {
+	Street string `json:"street" valid:"required"`
+}
+
+type nestedOrder struct {
+	Lines []nestedLine
+}
+
+func (o *nestedOrder) ValidateWith(v *Validator) {
+	v.Dive("lines", o.Lines)
+}
+
+func TestNested(t *testing.T) {
+	t.Run("validatable", func(t *testing.T) {
+		v := New()
+		v.Nested("line", nestedLine{})
+
+		assertErrors(t, v, "line.sku:required", "line.quantity:min_value")
+	})
+
+	t.Run("pointer receiver", func(t *testing.T) {
+		v := New()
+		v.Nested("order", nestedOrder{Lines: []nestedLine{{SKU: "a", Quantity: 1}, {}}})
+
+		assertErrors(t, v, "order.lines[1].sku:required", "order.lines[1].quantity:min_value")
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		v := New()
+		v.Nested("address", &nestedAddress{})
+
+		assertErrors(t, v, "address.street:required")
+	})
+
+	t.Run("nil and time are skipped", func(t *testing.T) {
+		v := New()
+		v.Nested("address", (*nestedAddress)(nil))
+		v.Nested("order", nil)
+		v.Nested("at", time.Time{})
+
+		assertErrors(t, v)
+	})
+}
+
+func TestDive(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		v := New()
+		v.Dive("addresses", []*nestedAddress{{Street: "Main"}, nil, {}})
+
+		assertErrors(t, v, "addresses[2].street:required")
+	})
+
+	t.Run("map in key order", func(t *testing.T) {
+		v := New()
+		v.Dive("addresses", map[string]nestedAddress{"work": {}, "home": {}})
+
+		assertErrors(t, v, "addresses[home].street:required", "addresses[work].street:required")
+	})
+
+	t.Run("not a collection", func(t *testing.T) {
+		assertPanics(t, func() { New().Dive("address", nestedAddress{}) })
+	})
+}
@@ -9,19 +9,60 @@ type NumberValidator[T constraints.Integer] struct {
 	v     *Validator
 	field string
 	value T
+	isNil bool
+	isPtr bool
+	skip  bool
 }
 
 // Int validates an integer field with the given options
 func (v *Validator) Int(field string, value int64, opts ...NumberOption[int64]) {
 	nv := &NumberValidator[int64]{v: v, field: field, value: value}
-	for _, opt := range opts {
-		opt(nv)
-	}
+	nv.run(opts)
 }
 
 func (v *Validator) Uint(field string, value uint, opts ...NumberOption[uint]) {
 	nv := &NumberValidator[uint]{v: v, field: field, value: value}
+	nv.run(opts)
+}
+
+// IntPtr validates an optional integer field with the given options. A nil
+// pointer is validated as zero unless Optional or Nullable skip it
+func (v *Validator) IntPtr(field string, value *int64, opts ...NumberOption[int64]) {
+	newNumberPtrValidator(v, field, value).run(opts)
+}
+
+// UintPtr validates an optional unsigned integer field with the given options. A
+// nil pointer is validated as zero unless Optional or Nullable skip it
+func (v *Validator) UintPtr(field string, value *uint, opts ...NumberOption[uint]) {
+	newNumberPtrValidator(v, field, value).run(opts)
+}
+
+// NumberPtr validates a pointer to any integer type with the given options, for
+// the types without a dedicated method such as *int or *int32. A nil pointer is
+// validated as zero unless Optional or Nullable skip it
+func NumberPtr[T constraints.Integer](v *Validator, field string, value *T, opts ...NumberOption[T]) {
+	newNumberPtrValidator(v, field, value).run(opts)
+}
+
+func newNumberPtrValidator[T constraints.Integer](v *Validator, field string, value *T) *NumberValidator[T] {
+	nv := &NumberValidator[T]{v: v, field: field, isNil: value == nil, isPtr: true}
+	if value != nil {
+		nv.value = *value
+	}
+
+	return nv
+}
+
+// run applies the options until one of them skips the remaining rules or,
+// with WithFailFast, adds an error
+func (nv *NumberValidator[T]) run(opts []NumberOption[T]) {
+	var failed func() bool
+	nv.v, failed = nv.v.failFast()
+
 	for _, opt := range opts {
+		if nv.skip || failed() {
+			return
+		}
 		opt(nv)
 	}
 }
@@ -1,9 +1,43 @@
 package valid
 
-// Required validates that the number is not zero
+// Optional skips the remaining rules when the number is zero or, for pointers,
+// only when it is nil
+func (b *NumberRuleBuilder[T]) Optional() *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if nv.isNil || !nv.isPtr && nv.value == 0 {
+			nv.skip = true
+		}
+	})
+	return b
+}
+
+// Nullable skips the remaining rules when the number is nil, so zero is still
+// validated
+func (b *NumberRuleBuilder[T]) Nullable() *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if nv.isNil {
+			nv.skip = true
+		}
+	})
+	return b
+}
+
+// NotNil validates that the number is present, accepting zero
+func (b *NumberRuleBuilder[T]) NotNil() *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if nv.isNil {
+			nv.v.AddError(nv.field, MsgNotNil, nil)
+			nv.skip = true
+		}
+	})
+	return b
+}
+
+// Required validates that the number is not zero or, for pointers, that it is
+// not nil, so a pointer to zero is accepted
 func (b *NumberRuleBuilder[T]) Required() *NumberRuleBuilder[T] {
 	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
-		if nv.value == 0 {
+		if nv.isNil || !nv.isPtr && nv.value == 0 {
 			nv.v.AddError(nv.field, MsgRequired, nil)
 		}
 	})
@@ -15,7 +49,7 @@ func (b *NumberRuleBuilder[T]) Min(min T) *NumberRuleBuilder[T] {
 	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
 		if nv.value < min {
 			nv.v.AddError(nv.field, MsgMinValue, MessageParams{
-				"v": min,
+				"min": min,
 			})
 		}
 	})
@@ -27,7 +61,7 @@ func (b *NumberRuleBuilder[T]) Max(max T) *NumberRuleBuilder[T] {
 	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
 		if nv.value > max {
 			nv.v.AddError(nv.field, MsgMaxValue, MessageParams{
-				"v": max,
+				"max": max,
 			})
 		}
 	})
@@ -39,8 +73,56 @@ func (b *NumberRuleBuilder[T]) Between(min, max T) *NumberRuleBuilder[T] {
 	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
 		if nv.value < min || nv.value > max {
 			nv.v.AddError(nv.field, MsgBetween, MessageParams{
-				"v1": min,
-				"v2": max,
+				"min": min,
+				"max": max,
+			})
+		}
+	})
+	return b
+}
+
+// EqualTo validates that the number matches the value of another field
+func (b *NumberRuleBuilder[T]) EqualTo(otherField string, otherValue T) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if nv.value != otherValue {
+			nv.v.AddError(nv.field, MsgEqualTo, MessageParams{
+				"other": otherField,
+			})
+		}
+	})
+	return b
+}
+
+// NotEqualTo validates that the number differs from the value of another field
+func (b *NumberRuleBuilder[T]) NotEqualTo(otherField string, otherValue T) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if nv.value == otherValue {
+			nv.v.AddError(nv.field, MsgNotEqualTo, MessageParams{
+				"other": otherField,
+			})
+		}
+	})
+	return b
+}
+
+// GreaterThanField validates that the number is greater than the value of another field
+func (b *NumberRuleBuilder[T]) GreaterThanField(otherField string, otherValue T) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if nv.value <= otherValue {
+			nv.v.AddError(nv.field, MsgGreaterThanField, MessageParams{
+				"other": otherField,
+			})
+		}
+	})
+	return b
+}
+
+// LessThanField validates that the number is less than the value of another field
+func (b *NumberRuleBuilder[T]) LessThanField(otherField string, otherValue T) *NumberRuleBuilder[T] {
+	b.rules = append(b.rules, func(nv *NumberValidator[T]) {
+		if nv.value >= otherValue {
+			nv.v.AddError(nv.field, MsgLessThanField, MessageParams{
+				"other": otherField,
 			})
 		}
 	})
@@ -0,0 +1,95 @@
+package valid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOptional(t *testing.T) {
+	zero, empty, now := int64(0), "", time.Time{}
+	small := int32(0)
+
+	t.Run("values skip when zero", func(t *testing.T) {
+		v := New()
+		v.String("email", "", StringRules().Optional().Email().Build()...)
+		v.Int("stock", 0, NumberRules[int64]().Optional().Min(1).Build()...)
+		v.Float64("price", 0, FloatRules[float64]().Optional().Min(1).Build()...)
+		v.Time("at", time.Time{}, TimeRules().Optional().Future().Build()...)
+
+		assertErrors(t, v)
+	})
+
+	t.Run("pointers skip only when nil", func(t *testing.T) {
+		v := New()
+		v.StringPtr("email", nil, StringRules().Optional().Email().Build()...)
+		v.IntPtr("stock", nil, NumberRules[int64]().Optional().Min(1).Build()...)
+		v.StringPtr("name", &empty, StringRules().Optional().MinLength(1).Build()...)
+		v.IntPtr("quantity", &zero, NumberRules[int64]().Optional().Min(1).Build()...)
+		NumberPtr(v, "small", &small, NumberRules[int32]().Optional().Min(1).Build()...)
+		v.Float64Ptr("price", new(float64), FloatRules[float64]().Optional().Min(1).Build()...)
+		v.TimePtr("at", &now, TimeRules().Optional().Future().Build()...)
+
+		assertErrors(t, v,
+			"name:min_length",
+			"quantity:min_value",
+			"small:min_value",
+			"price:min_value",
+			"at:future",
+		)
+	})
+}
+
+func TestRequiredPointer(t *testing.T) {
+	zero, empty, now := int64(0), "", time.Time{}
+
+	v := New()
+	v.IntPtr("stock", &zero, NumberRules[int64]().Required().Build()...)
+	v.Float32Ptr("ratio", new(float32), FloatRules[float32]().Required().Build()...)
+	v.TimePtr("at", &now, TimeRules().Required().Build()...)
+	v.Int("count", 0, NumberRules[int64]().Required().Build()...)
+	v.IntPtr("missing", nil, NumberRules[int64]().Required().Build()...)
+	v.StringPtr("name", &empty, StringRules().Required().Build()...)
+
+	assertErrors(t, v, "count:required", "missing:required", "name:required")
+}
+
+func TestNullableAndNotNil(t *testing.T) {
+	zero := uint(0)
+
+	v := New()
+	v.UintPtr("a", nil, NumberRules[uint]().Nullable().Min(1).Build()...)
+	v.UintPtr("b", &zero, NumberRules[uint]().Nullable().Min(1).Build()...)
+	v.UintPtr("c", nil, NumberRules[uint]().NotNil().Min(1).Build()...)
+	v.UintPtr("d", &zero, NumberRules[uint]().NotNil().Build()...)
+
+	assertErrors(t, v, "b:min_value", "c:not_nil")
+}
+
+type optionalTags struct {
+	Stock   *int      `json:"stock" valid:"optional,min=1"`
+	Count   *int      `json:"count" valid:"required"`
+	Address *TagBase  `json:"address" valid:"required"`
+	Billing *TagBase  `json:"billing" valid:"notnil"`
+	Extra   *TagBase  `json:"extra" valid:"optional"`
+	Other   *TagAudit `json:"other" valid:"nullable"`
+}
+
+func TestOptionalTags(t *testing.T) {
+	zero := 0
+
+	v := New()
+	v.Struct(&optionalTags{Stock: &zero, Count: &zero, Billing: &TagBase{}})
+
+	assertErrors(t, v, "stock:min_value", "address:required", "billing.id:required")
+
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Base TagBase `valid:"required"`
+		}{})
+	})
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Base *TagBase `valid:"min=1"`
+		}{})
+	})
+}
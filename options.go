@@ -0,0 +1,94 @@
+package valid
+
+import (
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// Option configures a validator created with New. A slice of options can be
+// kept app-wide to create identically configured validators:
+//
+//	opts := []valid.Option{valid.WithTranslator(translator), valid.WithLocale(valid.LocaleEN)}
+//	v := valid.New(opts...)
+type Option func(*state)
+
+// WithTranslator sets the translator of the validator, e.g. one created from
+// custom catalogs
+func WithTranslator(translator Translator) Option {
+	return func(s *state) {
+		s.translator = translator
+	}
+}
+
+// WithLocale sets the locale the messages are rendered in, LocaleES by default
+func WithLocale(locale Locale) Option {
+	return func(s *state) {
+		s.locale = locale
+	}
+}
+
+// WithClock sets the function returning the current time, used by the Past,
+// Future, MinAge and MaxAge rules. It defaults to time.Now
+func WithClock(now func() time.Time) Option {
+	return func(s *state) {
+		s.now = now
+	}
+}
+
+// WithFailFast stops validating a field at its first error, so a field is
+// reported once even when several of its rules fail
+func WithFailFast() Option {
+	return func(s *state) {
+		s.failFast = true
+	}
+}
+
+// WithMaxErrors caps the number of errors reported by the validator. Errors
+// beyond the first n are dropped
+func WithMaxErrors(n int) Option {
+	return func(s *state) {
+		s.maxErrors = n
+	}
+}
+
+// WithFieldNameFunc sets how Struct names the fields in the errors. It
+// defaults to the json name of the field, falling back to its Go name
+func WithFieldNameFunc(fn func(reflect.StructField) string) Option {
+	return func(s *state) {
+		s.fieldName = fn
+	}
+}
+
+// WithConcurrency sets how many asynchronous checks Wait runs at the same time
+func WithConcurrency(n int) Option {
+	return func(s *state) {
+		s.workers = max(n, 1)
+	}
+}
+
+// now returns the current time according to the validator's clock
+func (v *Validator) now() time.Time {
+	if v.shared.now == nil {
+		return time.Now()
+	}
+
+	return v.shared.now()
+}
+
+// failFast returns the validator the rules of a field should report to and a
+// function telling whether they already failed. Without WithFailFast the field
+// never stops early
+func (v *Validator) failFast() (*Validator, func() bool) {
+	if !v.shared.failFast {
+		return v, func() bool { return false }
+	}
+
+	if v.failed == nil {
+		tracked := *v
+		tracked.failed = new(atomic.Bool)
+		v = &tracked
+	}
+
+	return v, v.failed.Load
+}
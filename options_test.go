@@ -0,0 +1,81 @@
+package valid
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithTranslatorAndLocale(t *testing.T) {
+	translator := NewTranslator(Catalog{LocaleEN: {MsgRequired: "cannot be blank"}})
+
+	v := New(WithTranslator(translator), WithLocale(LocaleEN))
+	v.String("name", "", StringRules().Required().Build()...)
+
+	if got := v.Errors()[0].Message; got != "cannot be blank" {
+		t.Errorf("message = %q, want the translator's message", got)
+	}
+
+	es := New()
+	es.String("name", "", StringRules().Required().Build()...)
+
+	if got, want := es.Errors()[0].Message, translator.Translate(LocaleES, MsgRequired, nil); got != want {
+		t.Errorf("default locale message = %q, want the Spanish %q", got, want)
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	birth := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	v := New(WithClock(func() time.Time { return now }))
+	v.Time("starts_at", now.Add(-time.Hour), TimeRules().Future().Build()...)
+	v.Time("ended_at", now.Add(time.Hour), TimeRules().Past().Build()...)
+	v.Time("birth", birth, TimeRules().MinAge(18).Build()...)
+
+	assertErrors(t, v, "starts_at:future", "ended_at:past", "birth:min_age")
+}
+
+func TestWithFailFast(t *testing.T) {
+	v := New(WithFailFast())
+	v.String("email", "x", StringRules().MinLength(3).Email().Build()...)
+	v.Int("age", 200, NumberRules[int64]().Max(130).Between(18, 130).Build()...)
+
+	assertErrors(t, v, "email:min_length", "age:max_value")
+
+	all := New()
+	all.String("email", "x", StringRules().MinLength(3).Email().Build()...)
+
+	assertErrors(t, all, "email:min_length", "email:email")
+}
+
+func TestWithMaxErrors(t *testing.T) {
+	v := New(WithMaxErrors(2))
+	for _, field := range []string{"a", "b", "c"} {
+		v.String(field, "", StringRules().Required().Build()...)
+	}
+
+	assertErrors(t, v, "a:required", "b:required")
+}
+
+func TestWithFieldNameFunc(t *testing.T) {
+	v := New(WithFieldNameFunc(func(sf reflect.StructField) string {
+		return strings.ToLower(sf.Name)
+	}))
+	v.Struct(&tagUser{})
+
+	if !v.Errors().Has("name") || !v.Errors().Has("tags") || v.Errors().Has("Role") {
+		t.Errorf("errors = %q, want lowercase Go names", fieldKeys(v.Errors()))
+	}
+}
+
+func TestWithConcurrency(t *testing.T) {
+	if got := New().shared.workers; got != defaultAsyncWorkers {
+		t.Errorf("default workers = %d, want %d", got, defaultAsyncWorkers)
+	}
+
+	if got := New(WithConcurrency(0)).shared.workers; got != 1 {
+		t.Errorf("WithConcurrency(0) workers = %d, want 1", got)
+	}
+}
@@ -0,0 +1,45 @@
+package valid
+
+import "math"
+
+// pluralCategory returns the CLDR plural category (one, many or other) of n
+// for the language of locale. Languages without known rules use the English
+// ones, and plural forms missing from a message fall back to other
+func pluralCategory(locale Locale, n float64) string {
+	// CLDR operands: n is the absolute value, i its integer part and fraction
+	// reports visible fraction digits (v != 0)
+	n = math.Abs(n)
+	i := math.Trunc(n)
+	fraction := n != i
+	million := !fraction && i != 0 && math.Mod(i, 1e6) == 0
+
+	switch locale.Base() {
+	case "es", "it":
+		switch {
+		case n == 1:
+			return "one"
+		case million:
+			return "many"
+		}
+	case "pt":
+		switch {
+		case locale == LocalePTPT && n == 1, locale != LocalePTPT && i <= 1:
+			return "one"
+		case million:
+			return "many"
+		}
+	case "fr":
+		switch {
+		case i <= 1:
+			return "one"
+		case million:
+			return "many"
+		}
+	default:
+		if n == 1 {
+			return "one"
+		}
+	}
+
+	return "other"
+}
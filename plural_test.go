@@ -0,0 +1,96 @@
+package valid
+
+import "testing"
+
+func TestPluralCategory(t *testing.T) {
+	tests := []struct {
+		locale Locale
+		n      float64
+		want   string
+	}{
+		{LocaleEN, 1, "one"},
+		{LocaleEN, 0, "other"},
+		{LocaleEN, 1.5, "other"},
+		{LocaleENGB, -1, "one"},
+		{LocaleES, 1, "one"},
+		{LocaleES, 2, "other"},
+		{LocaleES, 1e6, "many"},
+		{LocaleIT, 2e6, "many"},
+		{LocaleFR, 0, "one"},
+		{LocaleFR, 1.5, "one"},
+		{LocaleFR, 2, "other"},
+		{LocaleFR, 1e6, "many"},
+		{LocalePT, 0, "one"},
+		{LocalePTBR, 1.5, "one"},
+		{LocalePTPT, 0, "other"},
+		{LocalePTPT, 1, "one"},
+		{LocaleDE, 1, "one"},
+		{LocaleDE, 1e6, "other"},
+	}
+
+	for _, tt := range tests {
+		if got := pluralCategory(tt.locale, tt.n); got != tt.want {
+			t.Errorf("pluralCategory(%s, %v) = %q, want %q", tt.locale, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPluralTemplate(t *testing.T) {
+	tmpl, err := parseTemplate("{min, plural, =0 {no elements} one {# element} many {# de elements} other {# elements}} for {field}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		locale Locale
+		min    any
+		want   string
+	}{
+		{LocaleEN, 0, "no elements for tags"},
+		{LocaleEN, 1, "1 element for tags"},
+		{LocaleEN, int64(3), "3 elements for tags"},
+		{LocaleES, uint(1000000), "1000000 de elements for tags"},
+		{LocaleEN, 2.5, "2.5 elements for tags"},
+		{LocaleEN, "many", "many for tags"},
+	}
+
+	for _, tt := range tests {
+		got, err := tmpl.render(tt.locale, MessageParams{"min": tt.min, "field": "tags"})
+		if err != nil || got != tt.want {
+			t.Errorf("render(%s, %v) = %q, %v, want %q", tt.locale, tt.min, got, err, tt.want)
+		}
+	}
+}
+
+func TestPluralMessages(t *testing.T) {
+	v := New(WithLocale(LocaleEN))
+	v.String("code", "", StringRules().MinLength(1).Build()...)
+	v.String("name", "", StringRules().MinLength(2).Build()...)
+
+	errs := v.Errors()
+	if errs[0].Message == errs[1].Message || errs[0].Message != "minimum length is 1 character" {
+		t.Errorf("messages = %q, %q, want singular and plural forms", errs[0].Message, errs[1].Message)
+	}
+}
+
+func TestPluralRegionalLocale(t *testing.T) {
+	translator := NewTranslator()
+
+	tests := []struct {
+		locale Locale
+		min    int
+		want   string
+	}{
+		{LocalePT, 0, "deve ter pelo menos 0 elemento"},
+		{LocalePTBR, 0, "deve ter pelo menos 0 elemento"},
+		{LocalePTPT, 0, "deve ter pelo menos 0 elementos"},
+		{LocalePTPT, 1, "deve ter pelo menos 1 elemento"},
+		{Locale("pt_pt"), 0, "deve ter pelo menos 0 elementos"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.Translate(tt.locale, MsgSliceMinLength, MessageParams{"min": tt.min}); got != tt.want {
+			t.Errorf("Translate(%s, min=%d) = %q, want %q", tt.locale, tt.min, got, tt.want)
+		}
+	}
+}
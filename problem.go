@@ -0,0 +1,102 @@
+package valid
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// ProblemContentType is the media type of RFC 9457 problem details
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 9457 (formerly RFC 7807) problem details document, with
+// the validation errors in the errors extension member
+type Problem struct {
+	Type     string           `json:"type"`
+	Title    string           `json:"title"`
+	Status   int              `json:"status"`
+	Detail   string           `json:"detail,omitempty"`
+	Instance string           `json:"instance,omitempty"`
+	Errors   ValidationErrors `json:"errors,omitempty"`
+}
+
+// ProblemOptions sets the members of a Problem. Type defaults to about:blank,
+// Status to 422, Title to the text of the status and Detail to the number of
+// errors
+type ProblemOptions struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+}
+
+// Problem returns the errors as a problem details document
+func (v ValidationErrors) Problem(opts ProblemOptions) Problem {
+	p := Problem{
+		Type:     opts.Type,
+		Title:    opts.Title,
+		Status:   opts.Status,
+		Detail:   opts.Detail,
+		Instance: opts.Instance,
+		Errors:   v,
+	}
+
+	if p.Type == "" {
+		p.Type = "about:blank"
+	}
+	if p.Status == 0 {
+		p.Status = http.StatusUnprocessableEntity
+	}
+	if p.Title == "" {
+		p.Title = http.StatusText(p.Status)
+	}
+	if p.Detail == "" {
+		p.Detail = validationDetail(len(v))
+	}
+
+	return p
+}
+
+// WriteProblem writes err as a problem details response. Validation errors,
+// found with errors.As, are written with status 422; any other error is
+// written as a 500 without its message, so internal details are not leaked.
+// Nothing is written when err is nil or an empty ValidationErrors, e.g. the
+// result of Validator.Errors with no errors; once wrapped, an empty
+// ValidationErrors is not a validation error and is written as a 500
+func WriteProblem(w http.ResponseWriter, err error) {
+	if errs, ok := err.(ValidationErrors); err == nil || ok && len(errs) == 0 {
+		return
+	}
+
+	var p Problem
+
+	var errs ValidationErrors
+	var single ValidationError
+	switch {
+	case errors.As(err, &errs) && len(errs) > 0:
+		p = errs.Problem(ProblemOptions{})
+	case errors.As(err, &single):
+		p = ValidationErrors{single}.Problem(ProblemOptions{})
+	default:
+		p = Problem{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// validationDetail describes how many validation errors a problem holds
+func validationDetail(n int) string {
+	if n == 1 {
+		return "the request has 1 validation error"
+	}
+
+	return "the request has " + strconv.Itoa(n) + " validation errors"
+}
@@ -0,0 +1,86 @@
+package valid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProblem(t *testing.T) {
+	v := New(WithLocale(LocaleEN))
+	v.String("email", "", StringRules().Required().Build()...)
+	v.String("name", "a", StringRules().MinLength(3).Build()...)
+
+	p := v.Errors().Problem(ProblemOptions{Instance: "/users"})
+	if p.Type != "about:blank" || p.Status != http.StatusUnprocessableEntity || p.Title != "Unprocessable Entity" {
+		t.Errorf("defaults = %+v", p)
+	}
+
+	if p.Detail != "the request has 2 validation errors" || p.Instance != "/users" || len(p.Errors) != 2 {
+		t.Errorf("problem = %+v", p)
+	}
+
+	custom := v.Errors()[:1].Problem(ProblemOptions{Type: "https://example.com/validation", Status: http.StatusBadRequest, Detail: "invalid user"})
+	if custom.Type != "https://example.com/validation" || custom.Title != "Bad Request" || custom.Detail != "invalid user" {
+		t.Errorf("custom problem = %+v", custom)
+	}
+
+	single := ValidationErrors{{Field: "email"}}
+	if got := single.Problem(ProblemOptions{}).Detail; got != "the request has 1 validation error" {
+		t.Errorf("detail = %q", got)
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	v := New(WithLocale(LocaleEN))
+	v.String("email", "", StringRules().Required().Build()...)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		errors int
+	}{
+		{"validation errors", v.Errors(), http.StatusUnprocessableEntity, 1},
+		{"wrapped", fmt.Errorf("create user: %w", v.Errors()), http.StatusUnprocessableEntity, 1},
+		{"single error", v.Errors()[0], http.StatusUnprocessableEntity, 1},
+		{"other error", errors.New("db password leaked"), http.StatusInternalServerError, 0},
+		{"wrapped empty", fmt.Errorf("create user: %w", ValidationErrors{}), http.StatusInternalServerError, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			WriteProblem(w, tt.err)
+
+			if w.Code != tt.status || w.Header().Get("Content-Type") != ProblemContentType {
+				t.Errorf("status %d, content type %q", w.Code, w.Header().Get("Content-Type"))
+			}
+
+			if strings.Contains(w.Body.String(), "leaked") {
+				t.Error("the message of an internal error is written")
+			}
+
+			var p Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+				t.Fatal(err)
+			}
+
+			if p.Status != tt.status || len(p.Errors) != tt.errors {
+				t.Errorf("problem = %+v", p)
+			}
+		})
+	}
+
+	for _, err := range []error{nil, New().Errors(), ValidationErrors(nil)} {
+		w := httptest.NewRecorder()
+		WriteProblem(w, err)
+		if w.Body.Len() != 0 {
+			t.Errorf("%#v is written", err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package valid
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// errorKey identifies the errors that are duplicates of each other: those of
+// the same field, message key and params. Params are part of it so the
+// per-element errors of a slice, told apart by their index, are kept
+type errorKey struct {
+	field  string
+	key    MessageKey
+	params string
+}
+
+func newErrorKey(field string, key MessageKey, params MessageParams) errorKey {
+	var p string
+	if len(params) > 0 {
+		p = fmt.Sprint(params)
+	}
+
+	return errorKey{field: field, key: key, params: p}
+}
+
+func (e ValidationError) key() errorKey {
+	return newErrorKey(e.Field, e.MessageKey, e.Params)
+}
+
+// ByField groups the errors by field, keeping their order
+func (v ValidationErrors) ByField() map[string][]ValidationError {
+	fields := make(map[string][]ValidationError)
+	for _, err := range v {
+		fields[err.Field] = append(fields[err.Field], err)
+	}
+
+	return fields
+}
+
+// Has reports whether the field or any of its members has an error, e.g.
+// Has("address") is true when address.street has one
+func (v ValidationErrors) Has(field string) bool {
+	for _, err := range v {
+		if isUnder(err.Field, field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasKey reports whether the field has an error with the given message key
+func (v ValidationErrors) HasKey(field string, key MessageKey) bool {
+	for _, err := range v {
+		if err.Field == field && err.MessageKey == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// First returns the first error of the field
+func (v ValidationErrors) First(field string) (ValidationError, bool) {
+	for _, err := range v {
+		if err.Field == field {
+			return err, true
+		}
+	}
+
+	return ValidationError{}, false
+}
+
+// Under returns the errors of the field and its members, e.g. Under("items[2]")
+// returns the errors of items[2] and items[2].name
+func (v ValidationErrors) Under(field string) ValidationErrors {
+	return v.Filter(func(err ValidationError) bool {
+		return isUnder(err.Field, field)
+	})
+}
+
+// Filter returns the errors for which keep returns true
+func (v ValidationErrors) Filter(keep func(ValidationError) bool) ValidationErrors {
+	filtered := make(ValidationErrors, 0, len(v))
+	for _, err := range v {
+		if keep(err) {
+			filtered = append(filtered, err)
+		}
+	}
+
+	return filtered
+}
+
+// Merge returns the errors followed by the ones of others, leaving out
+// duplicates
+func (v ValidationErrors) Merge(others ...ValidationErrors) ValidationErrors {
+	merged := make(ValidationErrors, 0, len(v))
+	merged = append(merged, v...)
+	for _, other := range others {
+		merged = append(merged, other...)
+	}
+
+	return merged.Dedup()
+}
+
+// Dedup returns the errors without duplicates, those of the same field,
+// message key and params, keeping the first one
+func (v ValidationErrors) Dedup() ValidationErrors {
+	seen := make(map[errorKey]struct{}, len(v))
+
+	return v.Filter(func(err ValidationError) bool {
+		if _, ok := seen[err.key()]; ok {
+			return false
+		}
+		seen[err.key()] = struct{}{}
+
+		return true
+	})
+}
+
+// Sort returns the errors sorted by field, comparing indexes numerically so
+// items[2] comes before items[10]. Errors of the same field keep their order
+func (v ValidationErrors) Sort() ValidationErrors {
+	sorted := make(ValidationErrors, len(v))
+	copy(sorted, v)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return comparePaths(sorted[i].Field, sorted[j].Field) < 0
+	})
+
+	return sorted
+}
+
+// isUnder reports whether path is field or one of its members
+func isUnder(path, field string) bool {
+	rest, ok := strings.CutPrefix(path, field)
+
+	return ok && (rest == "" || field == "" || rest[0] == '.' || rest[0] == '[')
+}
+
+// comparePaths orders field paths segment by segment, indexes before names
+func comparePaths(a, b string) int {
+	as, bs := splitPath(a), splitPath(b)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		ai, aIndex := as[i].(int)
+		bi, bIndex := bs[i].(int)
+
+		switch {
+		case aIndex && bIndex:
+			if ai != bi {
+				return ai - bi
+			}
+		case aIndex != bIndex:
+			if aIndex {
+				return -1
+			}
+			return 1
+		default:
+			if c := strings.Compare(fmt.Sprint(as[i]), fmt.Sprint(bs[i])); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return len(as) - len(bs)
+}
@@ -0,0 +1,107 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+// queryErrors returns the errors used by the query tests
+func queryErrors() ValidationErrors {
+	v := New()
+	v.String("email", "", StringRules().Required().Build()...)
+	v.String("email", "x", StringRules().MinLength(3).Build()...)
+	v.Scope("address").String("street", "", StringRules().Required().Build()...)
+	v.Scope("items").Index(10).String("name", "", StringRules().Required().Build()...)
+	v.Scope("items").Index(2).String("name", "", StringRules().Required().Build()...)
+	v.String("address_line", "", StringRules().Required().Build()...)
+
+	return v.Errors()
+}
+
+func TestQuery(t *testing.T) {
+	errs := queryErrors()
+
+	if got := errs.ByField()["email"]; len(got) != 2 || got[1].MessageKey != MsgMinLength {
+		t.Errorf("ByField()[email] = %v", got)
+	}
+
+	for field, want := range map[string]bool{"address": true, "address.street": true, "items[2]": true, "items": true, "addr": false, "name": false, "": true} {
+		if got := errs.Has(field); got != want {
+			t.Errorf("Has(%q) = %v, want %v", field, got, want)
+		}
+	}
+
+	if !errs.HasKey("email", MsgMinLength) || errs.HasKey("email", MsgEmail) || errs.HasKey("address", MsgRequired) {
+		t.Error("HasKey() matches the wrong errors")
+	}
+
+	if first, ok := errs.First("email"); !ok || first.MessageKey != MsgRequired {
+		t.Errorf("First(email) = %v, %v", first, ok)
+	}
+
+	if _, ok := errs.First("phone"); ok {
+		t.Error("First(phone) found an error")
+	}
+
+	if got := fieldKeys(errs.Under("address")); !reflect.DeepEqual(got, []string{"address.street:required"}) {
+		t.Errorf("Under(address) = %q", got)
+	}
+
+	required := errs.Filter(func(err ValidationError) bool { return err.MessageKey == MsgRequired })
+	if len(required) != 5 {
+		t.Errorf("Filter() kept %d errors, want 5", len(required))
+	}
+}
+
+func TestSort(t *testing.T) {
+	errs := queryErrors()
+	want := []string{
+		"address.street:required",
+		"address_line:required",
+		"email:required",
+		"email:min_length",
+		"items[2].name:required",
+		"items[10].name:required",
+	}
+
+	if got := fieldKeys(errs.Sort()); !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort() = %q, want %q", got, want)
+	}
+
+	if fieldKeys(errs)[0] != "email:required" {
+		t.Error("Sort() modified the errors")
+	}
+}
+
+func TestMergeAndDedup(t *testing.T) {
+	a := New()
+	a.String("email", "", StringRules().Required().Build()...)
+	a.StringSlice("tags", []string{"", ""}).Each(func(v *Validator, i int, tag string) {
+		v.String("", tag, StringRules().Required().Build()...)
+	})
+
+	b := New()
+	b.String("email", "", StringRules().Required().Build()...)
+	b.Int("age", 1, NumberRules[int64]().Min(18).Build()...)
+	b.Int("age", 1, NumberRules[int64]().Min(21).Build()...)
+
+	got := fieldKeys(a.Errors().Merge(b.Errors()))
+	want := []string{"email:required", "tags[0]:required", "tags[1]:required", "age:min_value", "age:min_value"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %q, want %q", got, want)
+	}
+
+	dup := append(b.Errors(), b.Errors()...)
+	if got := dup.Dedup(); len(got) != 3 {
+		t.Errorf("Dedup() kept %d errors, want 3", len(got))
+	}
+}
+
+func TestAddErrorSkipsDuplicates(t *testing.T) {
+	v := New()
+	v.String("email", "", StringRules().Required().Build()...)
+	v.String("email", "", StringRules().Required().Build()...)
+	v.Int("age", 1, NumberRules[int64]().Min(18).Min(21).Build()...)
+
+	assertErrors(t, v, "email:required", "age:min_value", "age:min_value")
+}
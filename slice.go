@@ -33,7 +33,7 @@ func (sv *SliceValidator[T]) Required() *SliceValidator[T] {
 func (sv *SliceValidator[T]) MinLength(min int) *SliceValidator[T] {
 	if len(sv.value) < min {
 		sv.v.AddError(sv.field, MsgSliceMinLength, MessageParams{
-			"d": min,
+			"min": min,
 		})
 	}
 
@@ -44,7 +44,7 @@ func (sv *SliceValidator[T]) MinLength(min int) *SliceValidator[T] {
 func (sv *SliceValidator[T]) MaxLength(max int) *SliceValidator[T] {
 	if len(sv.value) > max {
 		sv.v.AddError(sv.field, MsgSliceMaxLength, MessageParams{
-			"d": max,
+			"max": max,
 		})
 	}
 
@@ -55,17 +55,19 @@ func (sv *SliceValidator[T]) MaxLength(max int) *SliceValidator[T] {
 func (sv *SliceValidator[T]) Length(length int) *SliceValidator[T] {
 	if len(sv.value) != length {
 		sv.v.AddError(sv.field, MsgSliceLength, MessageParams{
-			"d": length,
+			"length": length,
 		})
 	}
 
 	return sv
 }
 
-// Each applies a validation function to each element
+// Each applies a validation function to each element. The validator passed to
+// fn is scoped to the element, so its fields are reported as field[i].name
 func (sv *SliceValidator[T]) Each(fn func(*Validator, int, T)) *SliceValidator[T] {
+	scoped := sv.v.Scope(sv.field)
 	for i, item := range sv.value {
-		fn(sv.v, i, item)
+		fn(scoped.Index(i), i, item)
 	}
 
 	return sv
@@ -108,8 +110,8 @@ func (sv *Int64SliceValidator) Min(min int64) *Int64SliceValidator {
 	for i, val := range sv.value {
 		if val < min {
 			sv.v.AddError(sv.field, MsgSliceMin, MessageParams{
-				"d": i,
-				"v": min,
+				"index": i,
+				"min":   min,
 			})
 		}
 	}
@@ -122,8 +124,8 @@ func (sv *Int64SliceValidator) Max(max int64) *Int64SliceValidator {
 	for i, val := range sv.value {
 		if val > max {
 			sv.v.AddError(sv.field, MsgSliceMax, MessageParams{
-				"d": i,
-				"v": max,
+				"index": i,
+				"max":   max,
 			})
 		}
 	}
@@ -136,9 +138,9 @@ func (sv *Int64SliceValidator) Between(min, max int64) *Int64SliceValidator {
 	for i, val := range sv.value {
 		if val < min || val > max {
 			sv.v.AddError(sv.field, MsgSliceBetween, MessageParams{
-				"d":  i,
-				"v1": min,
-				"v2": max,
+				"index": i,
+				"min":   min,
+				"max":   max,
 			})
 		}
 	}
@@ -151,8 +153,8 @@ func (sv *Float64SliceValidator) Min(min float64) *Float64SliceValidator {
 	for i, val := range sv.value {
 		if val < min {
 			sv.v.AddError(sv.field, MsgSliceMin, MessageParams{
-				"d": i,
-				"v": min,
+				"index": i,
+				"min":   min,
 			})
 		}
 	}
@@ -165,8 +167,8 @@ func (sv *Float64SliceValidator) Max(max float64) *Float64SliceValidator {
 	for i, val := range sv.value {
 		if val > max {
 			sv.v.AddError(sv.field, MsgSliceMax, MessageParams{
-				"d": i,
-				"v": max,
+				"index": i,
+				"max":   max,
 			})
 		}
 	}
@@ -179,9 +181,9 @@ func (sv *Float64SliceValidator) Between(min, max float64) *Float64SliceValidato
 	for i, val := range sv.value {
 		if val < min || val > max {
 			sv.v.AddError(sv.field, MsgSliceBetween, MessageParams{
-				"d":  i,
-				"v1": min,
-				"v2": max,
+				"index": i,
+				"min":   min,
+				"max":   max,
 			})
 		}
 	}
@@ -0,0 +1,92 @@
+package valid
+
+import (
+	"log/slog"
+	"sort"
+	"strconv"
+)
+
+// LogValue implements slog.LogValuer, logging the error as a group of its
+// field, message, message key and params
+func (e ValidationError) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("field", e.Field),
+		slog.String("message", e.Message),
+		slog.String("message_key", string(e.MessageKey)),
+	}
+
+	if len(e.Params) > 0 {
+		names := make([]string, 0, len(e.Params))
+		for name := range e.Params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		params := make([]any, len(names))
+		for i, name := range names {
+			params[i] = slog.Any(name, e.Params[name])
+		}
+		attrs = append(attrs, slog.Group("params", params...))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer, logging the errors as a group with their
+// count, the number of errors per message key and every error by position:
+//
+//	count=2 keys.required=1 keys.email=1 errors.0.field=name errors.0.message_key=required ...
+func (v ValidationErrors) LogValue() slog.Value {
+	errs := make([]any, len(v))
+	for i, err := range v {
+		errs[i] = slog.Any(strconv.Itoa(i), err)
+	}
+
+	return slog.GroupValue(
+		slog.Int("count", len(v)),
+		v.keyCounts(),
+		slog.Group("errors", errs...),
+	)
+}
+
+// Summary returns a compact attribute for the logs of every request, holding
+// the count, the failed fields and the number of errors per message key
+// without messages or params:
+//
+//	logger.Warn("invalid request", errs.Summary())
+func (v ValidationErrors) Summary() slog.Attr {
+	fields := make([]string, 0, len(v))
+	seen := make(map[string]struct{}, len(v))
+	for _, err := range v {
+		if _, ok := seen[err.Field]; !ok {
+			seen[err.Field] = struct{}{}
+			fields = append(fields, err.Field)
+		}
+	}
+
+	return slog.Group("validation",
+		slog.Int("count", len(v)),
+		slog.Any("fields", fields),
+		v.keyCounts(),
+	)
+}
+
+// keyCounts returns the number of errors per message key, in the order the
+// keys first appear
+func (v ValidationErrors) keyCounts() slog.Attr {
+	var keys []MessageKey
+	counts := make(map[MessageKey]int)
+	for _, err := range v {
+		if counts[err.MessageKey] == 0 {
+			keys = append(keys, err.MessageKey)
+		}
+		counts[err.MessageKey]++
+	}
+
+	attrs := make([]any, len(keys))
+	for i, key := range keys {
+		attrs[i] = slog.Int(string(key), counts[key])
+	}
+
+	return slog.Group("keys", attrs...)
+}
@@ -0,0 +1,85 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// logLine logs the given attributes with a text handler and returns the line
+func logLine(args ...any) string {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("invalid request", args...)
+
+	return strings.TrimSpace(buf.String())
+}
+
+func TestLogValue(t *testing.T) {
+	v := New(WithLocale(LocaleEN))
+	v.String("name", "", StringRules().Required().Build()...)
+	v.Int("age", 10, NumberRules[int64]().Between(18, 130).Build()...)
+
+	got := logLine("errors", v.Errors())
+	for _, want := range []string{
+		"errors.count=2",
+		"errors.keys.required=1",
+		"errors.keys.between=1",
+		"errors.errors.0.field=name",
+		"errors.errors.0.message_key=required",
+		"errors.errors.1.params.max=130",
+		"errors.errors.1.params.min=18",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("log line %q does not contain %q", got, want)
+		}
+	}
+
+	if strings.Index(got, "params.max") > strings.Index(got, "params.min") {
+		t.Errorf("params are not sorted by name: %q", got)
+	}
+
+	if got := logLine("error", v.Errors()[0]); !strings.Contains(got, "error.field=name error.message=") || strings.Contains(got, "params") {
+		t.Errorf("log line of a single error = %q", got)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	v := New()
+	v.String("email", "", StringRules().Required().Build()...)
+	v.String("email", "x", StringRules().Email().Build()...)
+	v.String("name", "", StringRules().Required().Build()...)
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Warn("invalid request", v.Errors().Summary())
+
+	var entry struct {
+		Validation struct {
+			Count  int            `json:"count"`
+			Fields []string       `json:"fields"`
+			Keys   map[string]int `json:"keys"`
+		} `json:"validation"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+
+	got := entry.Validation
+	if got.Count != 3 || !reflect.DeepEqual(got.Fields, []string{"email", "name"}) || !reflect.DeepEqual(got.Keys, map[string]int{"required": 2, "email": 1}) {
+		t.Errorf("summary = %+v", got)
+	}
+
+	if strings.Contains(buf.String(), "message") {
+		t.Errorf("the summary holds messages: %s", buf.String())
+	}
+}
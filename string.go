@@ -5,12 +5,37 @@ type StringValidator struct {
 	v     *Validator
 	field string
 	value string
+	isNil bool
+	isPtr bool
+	skip  bool
 }
 
 // String validates a string field with the given options
 func (v *Validator) String(field string, value string, opts ...StringOption) {
 	sv := &StringValidator{v: v, field: field, value: value}
+	sv.run(opts)
+}
+
+// StringPtr validates an optional string field with the given options. A nil
+// pointer is validated as an empty string unless Optional or Nullable skip it
+func (v *Validator) StringPtr(field string, value *string, opts ...StringOption) {
+	sv := &StringValidator{v: v, field: field, isNil: value == nil, isPtr: true}
+	if value != nil {
+		sv.value = *value
+	}
+	sv.run(opts)
+}
+
+// run applies the options until one of them skips the remaining rules or,
+// with WithFailFast, adds an error
+func (sv *StringValidator) run(opts []StringOption) {
+	var failed func() bool
+	sv.v, failed = sv.v.failFast()
+
 	for _, opt := range opts {
+		if sv.skip || failed() {
+			return
+		}
 		opt(sv)
 	}
 }
@@ -6,7 +6,40 @@ import (
 	"github.com/google/uuid"
 )
 
-// Required validates that the string is not empty
+// Optional skips the remaining rules when the string is empty or, for pointers,
+// only when it is nil
+func (b *StringRuleBuilder) Optional() *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		if sv.isNil || !sv.isPtr && sv.value == "" {
+			sv.skip = true
+		}
+	})
+	return b
+}
+
+// Nullable skips the remaining rules when the string is nil
+func (b *StringRuleBuilder) Nullable() *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		if sv.isNil {
+			sv.skip = true
+		}
+	})
+	return b
+}
+
+// NotNil validates that the string is present, even if empty
+func (b *StringRuleBuilder) NotNil() *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		if sv.isNil {
+			sv.v.AddError(sv.field, MsgNotNil, nil)
+			sv.skip = true
+		}
+	})
+	return b
+}
+
+// Required validates that the string is not empty, so a pointer to an empty
+// string is rejected as well
 func (b *StringRuleBuilder) Required() *StringRuleBuilder {
 	b.rules = append(b.rules, func(sv *StringValidator) {
 		if sv.value == "" {
@@ -21,7 +54,7 @@ func (b *StringRuleBuilder) MinLength(min int) *StringRuleBuilder {
 	b.rules = append(b.rules, func(sv *StringValidator) {
 		if len(sv.value) < min {
 			sv.v.AddError(sv.field, MsgMinLength, MessageParams{
-				"d": min,
+				"min": min,
 			})
 		}
 	})
@@ -33,7 +66,7 @@ func (b *StringRuleBuilder) MaxLength(max int) *StringRuleBuilder {
 	b.rules = append(b.rules, func(sv *StringValidator) {
 		if len(sv.value) > max {
 			sv.v.AddError(sv.field, MsgMaxLength, MessageParams{
-				"d": max,
+				"max": max,
 			})
 		}
 	})
@@ -84,3 +117,28 @@ func (b *StringRuleBuilder) UUID() *StringRuleBuilder {
 	})
 	return b
 }
+
+// EqualTo validates that the string matches the value of another field,
+// e.g. a password confirmation
+func (b *StringRuleBuilder) EqualTo(otherField, otherValue string) *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		if sv.value != otherValue {
+			sv.v.AddError(sv.field, MsgEqualTo, MessageParams{
+				"other": otherField,
+			})
+		}
+	})
+	return b
+}
+
+// NotEqualTo validates that the string differs from the value of another field
+func (b *StringRuleBuilder) NotEqualTo(otherField, otherValue string) *StringRuleBuilder {
+	b.rules = append(b.rules, func(sv *StringValidator) {
+		if sv.value == otherValue {
+			sv.v.AddError(sv.field, MsgNotEqualTo, MessageParams{
+				"other": otherField,
+			})
+		}
+	})
+	return b
+}
@@ -0,0 +1,634 @@
+package valid
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// tagName is the struct tag read by Validator.Struct
+const tagName = "valid"
+
+// tagRule is a single rule parsed from a struct tag, e.g. max=120
+type tagRule struct {
+	name  string
+	param string
+}
+
+// structField holds the prepared validation of a single struct field, which
+// reports its errors under the given name
+type structField struct {
+	field    reflect.StructField
+	validate func(v *Validator, name string, fv reflect.Value)
+}
+
+// structCache stores the prepared fields per struct type
+var structCache sync.Map // map[reflect.Type][]structField
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Struct validates the exported fields of a struct using their `valid` tags.
+//
+// Tags hold a comma separated list of rules, e.g. `valid:"required,email,max=120"`.
+// Rules that take several arguments separate them with spaces, e.g.
+// `valid:"between=18 130"` or `valid:"oneof=admin user"`. The field name used in
+// the errors is taken from the json tag, falling back to the Go field name,
+// unless set with WithFieldNameFunc.
+//
+// Tagged struct fields are validated as Nested does, and the dive rule
+// validates every element of a slice, array or map the same way. The fields of
+// untagged embedded structs are validated as fields of the outer struct.
+//
+// Struct panics when s is not a struct or a tag is malformed, as both are
+// programming errors.
+func (v *Validator) Struct(s any) {
+	rv := reflect.ValueOf(s)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("valid: Struct expects a struct, got %s", rv.Kind()))
+	}
+
+	v.structValue(rv)
+}
+
+// structValue validates the tagged fields of a struct value
+func (v *Validator) structValue(rv reflect.Value) {
+	for _, f := range structFields(rv.Type()) {
+		// Fields promoted through a nil embedded pointer are absent
+		fv, err := rv.FieldByIndexErr(f.field.Index)
+		if err != nil {
+			continue
+		}
+
+		f.validate(v, v.shared.fieldName(f.field), fv)
+	}
+}
+
+// structFields returns the prepared fields of t, parsing its tags on first use
+func structFields(t reflect.Type) []structField {
+	if cached, ok := structCache.Load(t); ok {
+		return cached.([]structField)
+	}
+
+	fields := collectFields(nil, t, nil, map[reflect.Type]bool{t: true})
+	cached, _ := structCache.LoadOrStore(t, fields)
+
+	return cached.([]structField)
+}
+
+// collectFields appends the prepared tagged fields of t to fields. As in
+// encoding/json, the fields of exported embedded structs without a valid or
+// json name are promoted, i.e. validated as fields of the outer struct
+func collectFields(fields []structField, t reflect.Type, index []int, embedding map[reflect.Type]bool) []structField {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		sf.Index = append(index[:len(index):len(index)], i)
+
+		tag, tagged := sf.Tag.Lookup(tagName)
+		if tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		if embedded := promotedStruct(sf, tagged); embedded != nil {
+			if !embedding[embedded] {
+				embedding[embedded] = true
+				fields = collectFields(fields, embedded, sf.Index, embedding)
+				delete(embedding, embedded)
+			}
+			continue
+		}
+
+		if !tagged {
+			continue
+		}
+
+		fn, err := fieldValidator(sf.Type, parseTag(tag))
+		if err != nil {
+			panic(fmt.Sprintf("valid: %s.%s: %v", t.Name(), sf.Name, err))
+		}
+
+		fields = append(fields, structField{field: sf, validate: fn})
+	}
+
+	return fields
+}
+
+// promotedStruct returns the struct type whose fields sf promotes, or nil when
+// sf is not an untagged embedded struct or pointer to one
+func promotedStruct(sf reflect.StructField, tagged bool) reflect.Type {
+	if !sf.Anonymous || tagged {
+		return nil
+	}
+
+	if name, _, _ := strings.Cut(sf.Tag.Get("json"), ","); name != "" {
+		return nil
+	}
+
+	t := sf.Type
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t == timeType {
+		return nil
+	}
+
+	return t
+}
+
+// fieldName returns the json name of a struct field, or its Go name when it has none
+func fieldName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return sf.Name
+	}
+
+	return name
+}
+
+// parseTag splits a `valid` tag into its rules
+func parseTag(tag string) []tagRule {
+	var rules []tagRule
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, param, _ := strings.Cut(part, "=")
+		rules = append(rules, tagRule{name: name, param: param})
+	}
+
+	return rules
+}
+
+// fieldValidator prepares the validation of a field of type t
+func fieldValidator(t reflect.Type, rules []tagRule) (func(*Validator, string, reflect.Value), error) {
+	rules, dive := cutRule(rules, "dive")
+
+	if isNestedStruct(t) {
+		// Pointers to structs can be required to be present, the struct itself
+		// is validated through Nested
+		var presence []MessageKey
+		for _, r := range rules {
+			switch {
+			case t.Kind() != reflect.Pointer:
+				return nil, fmt.Errorf("rule %q is not supported on struct fields", r.name)
+			case r.name == "required":
+				presence = append(presence, MsgRequired)
+			case r.name == "notnil":
+				presence = append(presence, MsgNotNil)
+			case r.name != "optional" && r.name != "nullable":
+				return nil, fmt.Errorf("rule %q is not supported on struct pointer fields", r.name)
+			}
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			if fv.Kind() == reflect.Pointer && fv.IsNil() {
+				for _, key := range presence {
+					v.AddError(name, key, nil)
+				}
+			}
+
+			if fv.CanAddr() {
+				fv = fv.Addr()
+			}
+			v.Nested(name, fv.Interface())
+		}, nil
+	}
+
+	if dive {
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+		default:
+			return nil, fmt.Errorf("rule \"dive\" is not supported on %s fields", t)
+		}
+
+		if t.Kind() == reflect.Map {
+			if len(rules) > 0 {
+				return nil, fmt.Errorf("rule %q is not supported on map fields", rules[0].name)
+			}
+
+			return func(v *Validator, name string, fv reflect.Value) {
+				v.Dive(name, fv.Interface())
+			}, nil
+		}
+
+		inner, err := fieldValidator(t, rules)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			inner(v, name, fv)
+			v.Dive(name, fv.Interface())
+		}, nil
+	}
+
+	// Pointer fields keep track of nil, so the optional, nullable and notnil
+	// rules can tell an absent value from a zero one
+	elem, isPtr := t, t.Kind() == reflect.Pointer
+	if isPtr {
+		elem = t.Elem()
+	}
+
+	if elem == timeType {
+		opts, err := timeTagOptions(rules)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			tv := &TimeValidator{v: v, field: name, isPtr: isPtr}
+			if fv, tv.isNil = deref(fv); !tv.isNil {
+				tv.value = fv.Interface().(time.Time)
+			}
+			tv.run(opts)
+		}, nil
+	}
+
+	switch elem.Kind() {
+	case reflect.String:
+		opts, err := stringTagOptions(rules)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			sv := &StringValidator{v: v, field: name, isPtr: isPtr}
+			if fv, sv.isNil = deref(fv); !sv.isNil {
+				sv.value = fv.String()
+			}
+			sv.run(opts)
+		}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// Params are parsed with the size of the field, so max=300 is
+		// rejected on int8 fields as validgen does
+		opts, err := numberTagOptions(rules, func(s string) (int64, error) {
+			return strconv.ParseInt(s, 10, elem.Bits())
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			nv := &NumberValidator[int64]{v: v, field: name, isPtr: isPtr}
+			if fv, nv.isNil = deref(fv); !nv.isNil {
+				nv.value = fv.Int()
+			}
+			nv.run(opts)
+		}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		opts, err := numberTagOptions(rules, func(s string) (uint, error) {
+			n, err := strconv.ParseUint(s, 10, elem.Bits())
+			return uint(n), err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			nv := &NumberValidator[uint]{v: v, field: name, isPtr: isPtr}
+			if fv, nv.isNil = deref(fv); !nv.isNil {
+				nv.value = uint(fv.Uint())
+			}
+			nv.run(opts)
+		}, nil
+	case reflect.Float32:
+		opts, err := floatTagOptions[float32](rules)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			flv := &Float64Validator[float32]{v: v, field: name, isPtr: isPtr}
+			if fv, flv.isNil = deref(fv); !flv.isNil {
+				flv.value = float32(fv.Float())
+			}
+			flv.run(opts)
+		}, nil
+	case reflect.Float64:
+		opts, err := floatTagOptions[float64](rules)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			flv := &Float64Validator[float64]{v: v, field: name, isPtr: isPtr}
+			if fv, flv.isNil = deref(fv); !flv.isNil {
+				flv.value = fv.Float()
+			}
+			flv.run(opts)
+		}, nil
+	case reflect.Slice, reflect.Array:
+		checks, err := sliceTagChecks(rules)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v *Validator, name string, fv reflect.Value) {
+			length := 0
+			if fv, isNil := deref(fv); !isNil {
+				length = fv.Len()
+			}
+
+			// Only the length matters for slice tag rules, and zero-sized
+			// elements keep this from allocating.
+			sv := NewSliceValidator(v, name, make([]struct{}, length))
+			for _, check := range checks {
+				check(sv)
+			}
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+// deref returns the value a pointer field points to, reporting whether it is nil
+func deref(fv reflect.Value) (reflect.Value, bool) {
+	if fv.Kind() != reflect.Pointer {
+		return fv, false
+	}
+
+	if fv.IsNil() {
+		return fv, true
+	}
+
+	return fv.Elem(), false
+}
+
+// stringTagOptions maps tag rules onto StringRuleBuilder rules
+func stringTagOptions(rules []tagRule) ([]StringOption, error) {
+	b := StringRules()
+	for _, r := range rules {
+		switch r.name {
+		case "rule":
+			for _, name := range strings.Fields(r.param) {
+				b.Rule(name)
+			}
+		case "optional":
+			b.Optional()
+		case "nullable":
+			b.Nullable()
+		case "notnil":
+			b.NotNil()
+		case "required":
+			b.Required()
+		case "email":
+			b.Email()
+		case "uuid":
+			b.UUID()
+		case "oneof":
+			b.OneOf(strings.Fields(r.param)...)
+		case "min", "max":
+			n, err := strconv.Atoi(r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			if r.name == "min" {
+				b.MinLength(n)
+			} else {
+				b.MaxLength(n)
+			}
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return b.Build(), nil
+}
+
+// numberTagOptions maps tag rules onto NumberRuleBuilder rules
+func numberTagOptions[T constraints.Integer](rules []tagRule, parse func(string) (T, error)) ([]NumberOption[T], error) {
+	b := NumberRules[T]()
+	for _, r := range rules {
+		switch r.name {
+		case "rule":
+			for _, name := range strings.Fields(r.param) {
+				b.Rule(name)
+			}
+		case "optional":
+			b.Optional()
+		case "nullable":
+			b.Nullable()
+		case "notnil":
+			b.NotNil()
+		case "required":
+			b.Required()
+		case "min", "max":
+			n, err := parse(r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			if r.name == "min" {
+				b.Min(n)
+			} else {
+				b.Max(n)
+			}
+		case "between":
+			bounds, err := parseParams(r, 2, parse)
+			if err != nil {
+				return nil, err
+			}
+			b.Between(bounds[0], bounds[1])
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return b.Build(), nil
+}
+
+// floatTagOptions maps tag rules onto Float64RuleBuilder rules
+func floatTagOptions[T constraints.Float](rules []tagRule) ([]Float64Option[T], error) {
+	parse := func(s string) (T, error) {
+		f, err := strconv.ParseFloat(s, 64)
+		return T(f), err
+	}
+
+	b := FloatRules[T]()
+	for _, r := range rules {
+		switch r.name {
+		case "rule":
+			for _, name := range strings.Fields(r.param) {
+				b.Rule(name)
+			}
+		case "optional":
+			b.Optional()
+		case "nullable":
+			b.Nullable()
+		case "notnil":
+			b.NotNil()
+		case "required":
+			b.Required()
+		case "min", "max":
+			f, err := parse(r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			if r.name == "min" {
+				b.Min(f)
+			} else {
+				b.Max(f)
+			}
+		case "between":
+			bounds, err := parseParams(r, 2, parse)
+			if err != nil {
+				return nil, err
+			}
+			b.Between(bounds[0], bounds[1])
+		case "precision":
+			n, err := strconv.Atoi(r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+			b.Precision(n)
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return b.Build(), nil
+}
+
+// timeTagOptions maps tag rules onto TimeRuleBuilder rules
+func timeTagOptions(rules []tagRule) ([]TimeOption, error) {
+	b := TimeRules()
+	for _, r := range rules {
+		switch r.name {
+		case "rule":
+			for _, name := range strings.Fields(r.param) {
+				b.Rule(name)
+			}
+		case "optional":
+			b.Optional()
+		case "nullable":
+			b.Nullable()
+		case "notnil":
+			b.NotNil()
+		case "required":
+			b.Required()
+		case "past":
+			b.Past()
+		case "future":
+			b.Future()
+		case "after", "before":
+			t, err := time.Parse(time.RFC3339, r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			if r.name == "after" {
+				b.After(t)
+			} else {
+				b.Before(t)
+			}
+		case "minage", "maxage":
+			n, err := strconv.Atoi(r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			if r.name == "minage" {
+				b.MinAge(n)
+			} else {
+				b.MaxAge(n)
+			}
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return b.Build(), nil
+}
+
+// sliceTagChecks maps tag rules onto SliceValidator length rules
+func sliceTagChecks(rules []tagRule) ([]func(*SliceValidator[struct{}]), error) {
+	checks := make([]func(*SliceValidator[struct{}]), 0, len(rules))
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			checks = append(checks, func(sv *SliceValidator[struct{}]) { sv.Required() })
+		case "min", "max", "len":
+			n, err := strconv.Atoi(r.param)
+			if err != nil {
+				return nil, invalidParam(r, err)
+			}
+
+			switch r.name {
+			case "min":
+				checks = append(checks, func(sv *SliceValidator[struct{}]) { sv.MinLength(n) })
+			case "max":
+				checks = append(checks, func(sv *SliceValidator[struct{}]) { sv.MaxLength(n) })
+			default:
+				checks = append(checks, func(sv *SliceValidator[struct{}]) { sv.Length(n) })
+			}
+		default:
+			return nil, unknownRule(r)
+		}
+	}
+
+	return checks, nil
+}
+
+// isNestedStruct reports whether t is a struct, or a pointer to one, validated
+// through Nested
+func isNestedStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// cutRule removes the rule with the given name, reporting whether it was present
+func cutRule(rules []tagRule, name string) ([]tagRule, bool) {
+	for i, r := range rules {
+		if r.name == name {
+			return append(rules[:i:i], rules[i+1:]...), true
+		}
+	}
+
+	return rules, false
+}
+
+// parseParams parses the space separated arguments of a rule
+func parseParams[T any](r tagRule, n int, parse func(string) (T, error)) ([]T, error) {
+	fields := strings.Fields(r.param)
+	if len(fields) != n {
+		return nil, fmt.Errorf("rule %q expects %d arguments, got %d", r.name, n, len(fields))
+	}
+
+	values := make([]T, n)
+	for i, field := range fields {
+		value, err := parse(field)
+		if err != nil {
+			return nil, invalidParam(r, err)
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func invalidParam(r tagRule, err error) error {
+	return fmt.Errorf("invalid argument %q for rule %q: %w", r.param, r.name, err)
+}
+
+func unknownRule(r tagRule) error {
+	return fmt.Errorf("unknown rule %q", r.name)
+}
@@ -0,0 +1,160 @@
+package valid
+
+import (
+	"testing"
+	"time"
+)
+
+type tagUser struct {
+	Name     string    `json:"name" valid:"required,min=3,max=10"`
+	Email    string    `json:"email,omitempty" valid:"required,email"`
+	Role     string    `valid:"oneof=admin user"`
+	Age      int       `json:"age" valid:"between=18 130"`
+	Score    float64   `json:"score" valid:"precision=2"`
+	Born     time.Time `json:"born" valid:"past"`
+	Tags     []string  `json:"tags" valid:"min=1"`
+	Internal string
+	Skipped  string `valid:"-"`
+}
+
+type tagBase struct {
+	Key string `json:"key" valid:"required"`
+}
+
+type tagAudit struct {
+	UpdatedBy string `json:"updated_by" valid:"required"`
+}
+
+type tagEmbedding struct {
+	tagBase
+	*tagAudit
+	Base  TagBase
+	Named TagBase `json:"named"`
+	TagBase
+	*TagAudit
+	Name string `json:"name" valid:"required"`
+}
+
+type TagBase struct {
+	ID string `json:"id" valid:"required"`
+}
+
+type TagAudit struct {
+	CreatedBy string `json:"created_by" valid:"required"`
+}
+
+type TagNested struct {
+	TagBase `json:"base"`
+}
+
+func TestStructTags(t *testing.T) {
+	v := New()
+	v.Struct(&tagUser{
+		Name:  "Al",
+		Email: "invalid",
+		Role:  "root",
+		Age:   15,
+		Score: 1.234,
+		Born:  time.Now().Add(time.Hour),
+	})
+
+	assertErrors(t, v,
+		"name:min_length",
+		"email:email",
+		"Role:one_of",
+		"age:between",
+		"score:precision",
+		"born:past",
+		"tags:slice_min_length",
+	)
+}
+
+func TestStructTagsValid(t *testing.T) {
+	v := New()
+	v.Struct(tagUser{
+		Name:  "Alice",
+		Email: "alice@example.com",
+		Role:  "admin",
+		Age:   30,
+		Score: 1.23,
+		Born:  time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		Tags:  []string{"a"},
+	})
+
+	assertErrors(t, v)
+}
+
+func TestStructEmbedded(t *testing.T) {
+	t.Run("promoted fields", func(t *testing.T) {
+		v := New()
+		v.Struct(&tagEmbedding{TagAudit: &TagAudit{}})
+
+		// Unexported embedded types and nil embedded pointers are skipped,
+		// untagged non-embedded struct fields are not validated
+		assertErrors(t, v, "id:required", "created_by:required", "name:required")
+	})
+
+	t.Run("nil embedded pointer", func(t *testing.T) {
+		v := New()
+		v.Struct(&tagEmbedding{TagBase: TagBase{ID: "1"}, Name: "x"})
+
+		assertErrors(t, v)
+	})
+
+	t.Run("json name keeps the struct nested", func(t *testing.T) {
+		v := New()
+		v.Struct(TagNested{})
+
+		assertErrors(t, v)
+	})
+}
+
+func TestStructNilPointer(t *testing.T) {
+	v := New()
+	v.Struct((*tagUser)(nil))
+
+	assertErrors(t, v)
+}
+
+func TestStructPanics(t *testing.T) {
+	assertPanics(t, func() { New().Struct("not a struct") })
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Name string `valid:"unknown"`
+		}{})
+	})
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Age int `valid:"min=abc"`
+		}{})
+	})
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Age int `valid:"between=1"`
+		}{})
+	})
+
+	// Params must fit the field's type, as validgen requires
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Age int8 `valid:"max=300"`
+		}{})
+	})
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Level uint8 `valid:"between=1,256"`
+		}{})
+	})
+	assertPanics(t, func() {
+		New().Struct(struct {
+			Count *uint16 `valid:"min=-1"`
+		}{})
+	})
+
+	v := New()
+	v.Struct(struct {
+		Age   int8  `valid:"min=-128,max=127"`
+		Level uint8 `valid:"max=255"`
+	}{Age: -1, Level: 255})
+	assertErrors(t, v)
+}
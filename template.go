@@ -0,0 +1,324 @@
+package valid
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// messageTemplate is a parsed message with named placeholders, e.g.
+// "must be between {min} and {max}". Literal braces are written doubled, as
+// in "{{" and "}}".
+//
+// Placeholders can also pick a plural form by the locale's plural rules,
+// using the ICU syntax "{min, plural, one {# element} other {# elements}}".
+// Forms are selected by exact value (=0) first and then by plural category
+// (zero, one, two, few, many, other); other is required. Within a form, #
+// stands for the number, and braces can only be used for placeholders
+type messageTemplate struct {
+	nodes []templateNode
+}
+
+// templateNode is literal text, a placeholder, a plural placeholder or the #
+// of a plural form
+type templateNode struct {
+	text   string
+	param  string
+	forms  map[string]*messageTemplate
+	number bool
+}
+
+// templateParser parses message templates
+type templateParser struct {
+	src string
+	pos int
+}
+
+// parseTemplate parses a message template, rejecting unbalanced braces, empty
+// placeholders and malformed plurals
+func parseTemplate(s string) (*messageTemplate, error) {
+	p := &templateParser{src: s}
+
+	t, err := p.parse(false)
+	if err != nil {
+		return nil, fmt.Errorf("%w at offset %d in %q", err, p.pos, s)
+	}
+
+	return t, nil
+}
+
+// parseMessage parses the template of key, checking that built-in keys only
+// use the params they are reported with. Custom keys can use any param
+func parseMessage(key MessageKey, s string) (*messageTemplate, error) {
+	t, err := parseTemplate(s)
+	if err != nil {
+		return nil, err
+	}
+
+	provided, ok := messageParams[key]
+	if !ok {
+		return t, nil
+	}
+
+	for _, param := range t.params() {
+		if param != "field" && !slices.Contains(provided, param) {
+			return nil, fmt.Errorf("unknown param {%s} in %q, %s provides %s", param, s, key, strings.Join(append(provided[:len(provided):len(provided)], "field"), ", "))
+		}
+	}
+
+	return t, nil
+}
+
+// params returns the names of the params used by the template, including
+// those of plural forms
+func (t *messageTemplate) params() []string {
+	var params []string
+	for _, n := range t.nodes {
+		if n.param != "" {
+			params = append(params, n.param)
+		}
+
+		for _, form := range n.forms {
+			params = append(params, form.params()...)
+		}
+	}
+
+	return params
+}
+
+// parse parses until the end of the source or, within a plural form, until
+// the closing brace of the form, which is left for the caller
+func (p *templateParser) parse(inForm bool) (*messageTemplate, error) {
+	t := &messageTemplate{}
+
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			t.nodes = append(t.nodes, templateNode{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case !inForm && (c == '{' || c == '}') && p.peek(1) == c:
+			text.WriteByte(c)
+			p.pos += 2
+		case c == '{':
+			flush()
+			node, err := p.placeholder()
+			if err != nil {
+				return nil, err
+			}
+			t.nodes = append(t.nodes, node)
+		case c == '}':
+			if inForm {
+				flush()
+				return t, nil
+			}
+			return nil, errors.New("unexpected '}'")
+		case c == '#' && inForm:
+			flush()
+			t.nodes = append(t.nodes, templateNode{number: true})
+			p.pos++
+		default:
+			text.WriteByte(c)
+			p.pos++
+		}
+	}
+
+	if inForm {
+		return nil, errors.New("unclosed plural form")
+	}
+	flush()
+
+	return t, nil
+}
+
+// placeholder parses a placeholder starting at its opening brace
+func (p *templateParser) placeholder() (templateNode, error) {
+	p.pos++
+
+	name, end := p.until(",{}")
+	if name == "" {
+		return templateNode{}, errors.New("empty placeholder")
+	}
+
+	switch end {
+	case '}':
+		p.pos++
+		return templateNode{param: name}, nil
+	case ',':
+		p.pos++
+	default:
+		return templateNode{}, errors.New("unclosed placeholder")
+	}
+
+	if kind, end := p.until(",{}"); kind != "plural" || end != ',' {
+		return templateNode{}, fmt.Errorf("unsupported placeholder type %q", kind)
+	}
+	p.pos++
+
+	forms := make(map[string]*messageTemplate)
+	for {
+		selector, end := p.until("{}")
+		switch {
+		case end == '}' && selector == "":
+			p.pos++
+			if forms["other"] == nil {
+				return templateNode{}, errors.New("plural without an other form")
+			}
+			return templateNode{param: name, forms: forms}, nil
+		case end != '{' || selector == "" || strings.ContainsAny(selector, " \t"):
+			return templateNode{}, errors.New("malformed plural form")
+		}
+		p.pos++
+
+		form, err := p.parse(true)
+		if err != nil {
+			return templateNode{}, err
+		}
+		forms[selector] = form
+		p.pos++
+	}
+}
+
+// until advances to the next of the given bytes, returning the trimmed text
+// before it and the byte found, or 0 at the end of the source
+func (p *templateParser) until(stops string) (string, byte) {
+	start := p.pos
+	for p.pos < len(p.src) && !strings.ContainsRune(stops, rune(p.src[p.pos])) {
+		p.pos++
+	}
+
+	return strings.TrimSpace(p.src[start:p.pos]), p.peek(0)
+}
+
+// peek returns the byte at offset from the current position, or 0 past the end
+func (p *templateParser) peek(offset int) byte {
+	if p.pos+offset < len(p.src) {
+		return p.src[p.pos+offset]
+	}
+
+	return 0
+}
+
+// render replaces the placeholders with the given params, choosing plural
+// forms by the rules of locale. Placeholders without a param are left as they
+// are and reported in the returned error
+func (t *messageTemplate) render(locale Locale, params MessageParams) (string, error) {
+	var (
+		b       strings.Builder
+		missing []string
+	)
+	t.write(&b, locale, params, "", &missing)
+
+	if len(missing) > 0 {
+		return b.String(), errors.New("missing params: " + strings.Join(missing, ", "))
+	}
+
+	return b.String(), nil
+}
+
+// write renders the template into b, with number standing for # in plural forms
+func (t *messageTemplate) write(b *strings.Builder, locale Locale, params MessageParams, number string, missing *[]string) {
+	for _, n := range t.nodes {
+		switch {
+		case n.number:
+			b.WriteString(number)
+		case n.param == "":
+			b.WriteString(n.text)
+		default:
+			value, ok := params[n.param]
+			if !ok {
+				*missing = append(*missing, n.param)
+				b.WriteString("{" + n.param + "}")
+				continue
+			}
+
+			if n.forms == nil {
+				b.WriteString(formatParam(value))
+				continue
+			}
+
+			count, ok := toFloat(value)
+			if !ok {
+				b.WriteString(formatParam(value))
+				continue
+			}
+
+			form := n.forms["="+formatParam(value)]
+			if form == nil {
+				form = n.forms[pluralCategory(locale, count)]
+			}
+			if form == nil {
+				form = n.forms["other"]
+			}
+			form.write(b, locale, params, formatParam(value), missing)
+		}
+	}
+}
+
+// formatParam formats a param according to its type
+func formatParam(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case []string:
+		return strings.Join(v, ", ")
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	return fmt.Sprint(value)
+}
+
+// toFloat converts a numeric param, for plural selection
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
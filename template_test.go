@@ -0,0 +1,85 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplateRender(t *testing.T) {
+	tests := []struct {
+		template string
+		params   MessageParams
+		want     string
+		missing  bool
+	}{
+		{"must be between {min} and {max}", MessageParams{"min": 1, "max": 10}, "must be between 1 and 10", false},
+		{"{{literal}} {value}", MessageParams{"value": 1.5}, "{literal} 1.5", false},
+		{"one of: {values}", MessageParams{"values": []string{"a", "b"}}, "one of: a, b", false},
+		{"after {date}", MessageParams{"date": time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}, "after 2026-01-02T03:04:05Z", false},
+		{"at least {min}", nil, "at least {min}", true},
+	}
+
+	for _, tt := range tests {
+		tmpl, err := parseTemplate(tt.template)
+		if err != nil {
+			t.Fatalf("parseTemplate(%q): %v", tt.template, err)
+		}
+
+		got, err := tmpl.render(LocaleEN, tt.params)
+		if got != tt.want {
+			t.Errorf("render(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+
+		if (err != nil) != tt.missing {
+			t.Errorf("render(%q) error = %v, want missing params: %v", tt.template, err, tt.missing)
+		}
+	}
+}
+
+func TestParseTemplateErrors(t *testing.T) {
+	for _, s := range []string{"{", "}", "{}", "{min", "{n, select, other {x}}", "{n, plural, one {x}}", "{n, plural, other {x}"} {
+		if _, err := parseTemplate(s); err == nil {
+			t.Errorf("parseTemplate(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestParseMessageParams(t *testing.T) {
+	if _, err := parseMessage(MsgBetween, "{field} must be between {min} and {max}"); err != nil {
+		t.Errorf("valid params rejected: %v", err)
+	}
+
+	if _, err := parseMessage("custom", "{anything} goes"); err != nil {
+		t.Errorf("custom key params rejected: %v", err)
+	}
+
+	_, err := parseMessage(MsgMinValue, "must be at least {minimum, plural, one {# unit} other {# units}}")
+	if err == nil || !strings.Contains(err.Error(), "unknown param {minimum}") {
+		t.Errorf("parseMessage() = %v, want an unknown param error", err)
+	}
+}
+
+func TestNewTranslatorChecksParams(t *testing.T) {
+	assertPanics(t, func() {
+		NewTranslator(Catalog{LocaleEN: {MsgMaxLength: "at most {min} characters"}})
+	})
+
+	err := Catalog{LocaleES: {MsgBetween: "entre {from} y {to}"}}.Validate()
+	if err == nil || !strings.Contains(err.Error(), "es: between") {
+		t.Errorf("Validate() = %v, want an error for es: between", err)
+	}
+}
+
+func TestTranslateNamedParams(t *testing.T) {
+	translator := NewTranslator()
+
+	got := translator.Translate(LocaleEN, MsgBetween, MessageParams{"min": 18, "max": 130})
+	if want := "must be between 18 and 130"; !strings.Contains(got, want) {
+		t.Errorf("Translate() = %q, want it to contain %q", got, want)
+	}
+
+	if got := translator.Translate(LocaleEN, "unknown_key", nil); got != "unknown_key" {
+		t.Errorf("Translate() of an unknown key = %q, want the key", got)
+	}
+}
@@ -9,6 +9,9 @@ type TimeValidator struct {
 	v     *Validator
 	field string
 	value time.Time
+	isNil bool
+	isPtr bool
+	skip  bool
 }
 
 // TimeOption defines a validation option for time
@@ -26,7 +29,29 @@ func TimeRules() *TimeRuleBuilder {
 
 func (v *Validator) Time(field string, value time.Time, opts ...TimeOption) {
 	tv := &TimeValidator{v: v, field: field, value: value}
+	tv.run(opts)
+}
+
+// TimePtr validates an optional time field with the given options. A nil
+// pointer is validated as the zero time unless Optional or Nullable skip it
+func (v *Validator) TimePtr(field string, value *time.Time, opts ...TimeOption) {
+	tv := &TimeValidator{v: v, field: field, isNil: value == nil, isPtr: true}
+	if value != nil {
+		tv.value = *value
+	}
+	tv.run(opts)
+}
+
+// run applies the options until one of them skips the remaining rules or,
+// with WithFailFast, adds an error
+func (tv *TimeValidator) run(opts []TimeOption) {
+	var failed func() bool
+	tv.v, failed = tv.v.failFast()
+
 	for _, opt := range opts {
+		if tv.skip || failed() {
+			return
+		}
 		opt(tv)
 	}
 }
@@ -36,10 +61,46 @@ func (b *TimeRuleBuilder) Build() []TimeOption {
 	return b.rules
 }
 
-// Required checks if the time is not zero
+// Optional skips the remaining rules when the time is zero or, for pointers,
+// only when it is nil
+func (b *TimeRuleBuilder) Optional() *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		if tv.isNil || !tv.isPtr && tv.value.IsZero() {
+			tv.skip = true
+		}
+	})
+
+	return b
+}
+
+// Nullable skips the remaining rules when the time is nil
+func (b *TimeRuleBuilder) Nullable() *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		if tv.isNil {
+			tv.skip = true
+		}
+	})
+
+	return b
+}
+
+// NotNil validates that the time is present, even if zero
+func (b *TimeRuleBuilder) NotNil() *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		if tv.isNil {
+			tv.v.AddError(tv.field, MsgNotNil, nil)
+			tv.skip = true
+		}
+	})
+
+	return b
+}
+
+// Required checks that the time is not zero or, for pointers, that it is not
+// nil, so a pointer to the zero time is accepted
 func (b *TimeRuleBuilder) Required() *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
-		if tv.value.IsZero() {
+		if tv.isNil || !tv.isPtr && tv.value.IsZero() {
 			tv.v.AddError(tv.field, MsgRequired, nil)
 		}
 	})
@@ -50,7 +111,7 @@ func (b *TimeRuleBuilder) Required() *TimeRuleBuilder {
 // Past validates that the time is in the past
 func (b *TimeRuleBuilder) Past() *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
-		if tv.value.After(time.Now()) {
+		if tv.value.After(tv.v.now()) {
 			tv.v.AddError(tv.field, MsgPast, nil)
 		}
 	})
@@ -61,7 +122,7 @@ func (b *TimeRuleBuilder) Past() *TimeRuleBuilder {
 // Future validates that the time is in the future
 func (b *TimeRuleBuilder) Future() *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
-		if tv.value.Before(time.Now()) {
+		if tv.value.Before(tv.v.now()) {
 			tv.v.AddError(tv.field, MsgFuture, nil)
 		}
 	})
@@ -74,7 +135,7 @@ func (b *TimeRuleBuilder) After(t time.Time) *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
 		if !tv.value.After(t) {
 			tv.v.AddError(tv.field, MsgAfter, MessageParams{
-				"v": t.Format(time.RFC3339),
+				"date": t,
 			})
 		}
 	})
@@ -87,7 +148,7 @@ func (b *TimeRuleBuilder) Before(t time.Time) *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
 		if !tv.value.Before(t) {
 			tv.v.AddError(tv.field, MsgBefore, MessageParams{
-				"v": t.Format(time.RFC3339),
+				"date": t,
 			})
 		}
 	})
@@ -100,8 +161,8 @@ func (b *TimeRuleBuilder) Between(start, end time.Time) *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
 		if tv.value.Before(start) || tv.value.After(end) {
 			tv.v.AddError(tv.field, MsgBetweenDates, MessageParams{
-				"v1": start.Format(time.RFC3339),
-				"v2": end.Format(time.RFC3339),
+				"start": start,
+				"end":   end,
 			})
 		}
 	})
@@ -131,10 +192,10 @@ func (b *TimeRuleBuilder) WeekDay(days ...time.Weekday) *TimeRuleBuilder {
 // MaxAge validates that the time represents an age not exceeding the specified years
 func (b *TimeRuleBuilder) MaxAge(years int) *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
-		maxDate := time.Now().AddDate(-years, 0, 0)
+		maxDate := tv.v.now().AddDate(-years, 0, 0)
 		if tv.value.Before(maxDate) {
 			tv.v.AddError(tv.field, MsgMaxAge, MessageParams{
-				"d": years,
+				"years": years,
 			})
 		}
 	})
@@ -145,10 +206,37 @@ func (b *TimeRuleBuilder) MaxAge(years int) *TimeRuleBuilder {
 // MinAge validates that the time represents an age of at least the specified years
 func (b *TimeRuleBuilder) MinAge(years int) *TimeRuleBuilder {
 	b.rules = append(b.rules, func(tv *TimeValidator) {
-		minDate := time.Now().AddDate(-years, 0, 0)
+		minDate := tv.v.now().AddDate(-years, 0, 0)
 		if tv.value.After(minDate) {
 			tv.v.AddError(tv.field, MsgMinAge, MessageParams{
-				"d": years,
+				"years": years,
+			})
+		}
+	})
+
+	return b
+}
+
+// AfterField validates that the time is after the value of another field,
+// e.g. an end date after its start date
+func (b *TimeRuleBuilder) AfterField(otherField string, t time.Time) *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		if !tv.value.After(t) {
+			tv.v.AddError(tv.field, MsgAfterField, MessageParams{
+				"other": otherField,
+			})
+		}
+	})
+
+	return b
+}
+
+// BeforeField validates that the time is before the value of another field
+func (b *TimeRuleBuilder) BeforeField(otherField string, t time.Time) *TimeRuleBuilder {
+	b.rules = append(b.rules, func(tv *TimeValidator) {
+		if !tv.value.Before(t) {
+			tv.v.AddError(tv.field, MsgBeforeField, MessageParams{
+				"other": otherField,
 			})
 		}
 	})
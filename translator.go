@@ -1,123 +1,71 @@
 package valid
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
+// defaultTranslator renders the messages of its catalogs. It is immutable once
+// created, so it is safe for concurrent use
 type defaultTranslator struct {
-	locale   Locale
-	messages map[Locale]map[MessageKey]string
+	fallback Locale
+	messages map[Locale]map[MessageKey]*messageTemplate
 }
 
-func NewTranslator() Translator {
+// NewTranslator creates a translator with the built-in messages, overridden by
+// the messages of the given catalogs in order. It panics if a catalog holds an
+// invalid template or one using a param its key does not provide; catalogs
+// returned by LoadCatalog are already checked
+func NewTranslator(catalogs ...Catalog) Translator {
 	t := &defaultTranslator{
-		locale:   LocaleES,
-		messages: make(map[Locale]map[MessageKey]string),
+		fallback: LocaleEN,
+		messages: make(map[Locale]map[MessageKey]*messageTemplate),
 	}
 
 	// Initialize with default messages
-	t.messages[LocaleES] = map[MessageKey]string{
-		MsgRequired:       "el campo es requerido",
-		MsgMinLength:      "la longitud mínima es %d",
-		MsgMaxLength:      "la longitud máxima es %d",
-		MsgEmail:          "formato de correo electrónico inválido",
-		MsgMinValue:       "debe ser mayor o igual a %v",
-		MsgMaxValue:       "debe ser menor o igual a %v",
-		MsgBetween:        "debe estar entre %v y %v",
-		MsgPrecision:      "debe tener máximo %d decimales",
-		MsgPast:           "debe estar en el pasado",
-		MsgFuture:         "debe estar en el futuro",
-		MsgAfter:          "debe ser posterior a %v",
-		MsgBefore:         "debe ser anterior a %v",
-		MsgBetweenDates:   "debe estar entre %v y %v",
-		MsgWeekday:        "debe ser un día válido de la semana",
-		MsgMaxAge:         "la edad no puede exceder %d años",
-		MsgMinAge:         "la edad debe ser al menos %d años",
-		MsgSliceRequired:  "el campo es requerido",
-		MsgSliceMinLength: "debe tener al menos %d elementos",
-		MsgSliceMaxLength: "debe tener máximo %d elementos",
-		MsgSliceLength:    "debe tener exactamente %d elementos",
-		MsgSliceMin:       "el elemento en la posición %d debe ser mayor o igual a %v",
-		MsgSliceMax:       "el elemento en la posición %d debe ser menor o igual a %v",
-		MsgSliceBetween:   "el elemento en la posición %d debe estar entre %v y %v",
-		MsgInvalidUUID:    "UUID inválido",
-		MsgOneOf:          "debe ser uno de de los valores permitidos",
-	}
+	for _, c := range append([]Catalog{defaultMessages}, catalogs...) {
+		for locale, msgs := range c {
+			locale = ParseLocale(string(locale))
+			if t.messages[locale] == nil {
+				t.messages[locale] = make(map[MessageKey]*messageTemplate, len(msgs))
+			}
 
-	t.messages[LocaleEN] = map[MessageKey]string{
-		MsgRequired:       "field is required",
-		MsgMinLength:      "minimum length is %d",
-		MsgMaxLength:      "maximum length is %d",
-		MsgEmail:          "invalid email format",
-		MsgMinValue:       "must be greater than or equal to %v",
-		MsgMaxValue:       "must be less than or equal to %v",
-		MsgBetween:        "must be between %v and %v",
-		MsgPrecision:      "must have maximum %d decimal places",
-		MsgPast:           "must be in the past",
-		MsgFuture:         "must be in the future",
-		MsgAfter:          "must be after %v",
-		MsgBefore:         "must be before %v",
-		MsgBetweenDates:   "must be between %v and %v",
-		MsgWeekday:        "must be on a valid weekday",
-		MsgMaxAge:         "age cannot exceed %d years",
-		MsgMinAge:         "age must be at least %d years",
-		MsgSliceRequired:  "field is required",
-		MsgSliceMinLength: "must have at least %d elements",
-		MsgSliceMaxLength: "must have maximum %d elements",
-		MsgSliceLength:    "must have exactly %d elements",
-		MsgSliceMin:       "element at position %d must be greater than or equal to %v",
-		MsgSliceMax:       "element at position %d must be less than or equal to %v",
-		MsgSliceBetween:   "element at position %d must be between %v and %v",
-		MsgInvalidUUID:    "invalid uuid",
-		MsgOneOf:          "mut be one of the allowed values",
+			for key, msg := range msgs {
+				tmpl, err := parseMessage(key, msg)
+				if err != nil {
+					panic(fmt.Sprintf("valid: %s: %s: %v", locale, key, err))
+				}
+				t.messages[locale][key] = tmpl
+			}
+		}
 	}
 
 	return t
 }
 
+// Translate renders the message of key with the given params. Missing
+// messages are looked up along the fallback chain of the locale, e.g.
+// es-PE -> es -> en, and the key itself is returned only when no locale of the
+// chain has it. Placeholders without a matching param are left in the message
+// as they are, e.g. {min}
 func (t *defaultTranslator) Translate(locale Locale, key MessageKey, params MessageParams) string {
-	msgs, ok := t.messages[locale]
-	if !ok {
-		return string(key)
-	}
-
-	msg, ok := msgs[key]
-	if !ok {
-		return string(key)
-	}
+	requested := ParseLocale(string(locale))
+	for _, loc := range requested.Fallbacks(t.fallback) {
+		tmpl, ok := t.messages[loc][key]
+		if !ok {
+			continue
+		}
 
-	if params != nil {
-		var args []interface{}
-		// Extract parameters in the order they appear in the format string
-		for _, param := range extractParams(msg) {
-			if val, ok := params[param]; ok {
-				args = append(args, val)
-			}
+		// Templates of built-in keys were checked against the params their
+		// keys provide, so only custom keys reported without some param can
+		// miss one, which is left in the message as documented
+		// Plural forms follow the requested locale while the message is in
+		// its language, e.g. pt-PT rules with the pt message
+		plural := loc
+		if loc.Base() == requested.Base() {
+			plural = requested
 		}
+		msg, _ := tmpl.render(plural, params)
 
-		return fmt.Sprintf(msg, args...)
+		return msg
 	}
 
-	return string(msg)
-}
-
-func (t *defaultTranslator) SetLocale(locale Locale) {
-	t.locale = locale
-}
-
-func (t *defaultTranslator) GetLocale() Locale {
-	return t.locale
-}
-
-// Helper function to extract parameter names from format string
-func extractParams(format string) []string {
-	var params []string
-	parts := strings.Split(format, "%")
-	for i := 1; i < len(parts); i++ {
-		if len(parts[i]) > 0 {
-			params = append(params, string(parts[i][0]))
-		}
-	}
-	return params
+	return string(key)
 }
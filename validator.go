@@ -1,15 +1,26 @@
 package valid
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // ValidationError represents a single validation error
 type ValidationError struct {
-	Field      string     `json:"field"`
-	Message    string     `json:"message"`
-	MessageKey MessageKey `json:"message_key"`
+	Field      string        `json:"field"`
+	Message    string        `json:"message"`
+	MessageKey MessageKey    `json:"message_key"`
+	Params     MessageParams `json:"params,omitempty"`
+
+	// labels are the field labels set with Validator.Label when the error was
+	// added, so Localize renders the same field names
+	labels map[string]map[Locale]string
 }
 
 func (e ValidationError) Error() string {
@@ -28,7 +39,24 @@ func (v ValidationErrors) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// LogFields method for logging
+// Localize renders the messages again in the given locale, from their message
+// keys and params. Field labels come from the labels set with Validator.Label
+// and then from the translator's catalogs. The errors are left untouched and a
+// localized copy is returned
+func (v ValidationErrors) Localize(translator Translator, locale Locale) ValidationErrors {
+	localized := make(ValidationErrors, len(v))
+	for i, err := range v {
+		params := withLabels(translator, err.labels, locale, err.Field, err.Params)
+		err.Message = translator.Translate(locale, err.MessageKey, params)
+		localized[i] = err
+	}
+
+	return localized
+}
+
+// LogFields returns the errors as key-value pairs for loggers taking them as
+// variadic arguments. With log/slog, log the errors directly instead, as they
+// implement slog.LogValuer
 func (v ValidationErrors) LogFields() []interface{} {
 	const keyValuePairs = 2
 	// Convert validation errors to key-value pairs
@@ -38,8 +66,12 @@ func (v ValidationErrors) LogFields() []interface{} {
 	errMaps := make([]map[string]interface{}, len(v))
 	for i, err := range v {
 		errMaps[i] = map[string]interface{}{
-			"field":   err.Field,
-			"message": err.Message,
+			"field":       err.Field,
+			"message":     err.Message,
+			"message_key": err.MessageKey,
+		}
+		if len(err.Params) > 0 {
+			errMaps[i]["params"] = err.Params
 		}
 	}
 
@@ -50,38 +82,160 @@ func (v ValidationErrors) LogFields() []interface{} {
 
 // Validator is the main validator instance
 type Validator struct {
+	shared *state
+	path   string
+	ctx    context.Context
+	failed *atomic.Bool
+}
+
+// state holds what a validator shares with the scoped validators created from
+// it. It is guarded by mu, so a validator can be used from several goroutines,
+// e.g. to validate the elements of a collection in parallel
+type state struct {
+	mu         sync.Mutex
 	errors     ValidationErrors
 	translator Translator
+	locale     Locale
+	rules      *ruleRegistry
+	pending    []asyncCheck
+	labels     map[string]map[Locale]string
+	seen       map[errorKey]struct{}
+
+	// Settings of the options, fixed once the validator is created
+	now       func() time.Time
+	failFast  bool
+	maxErrors int
+	fieldName func(reflect.StructField) string
+	workers   int
 }
 
-// New creates a new validator instance
-func New() *Validator {
-	return &Validator{
+// New creates a new validator instance configured by the given options
+func New(opts ...Option) *Validator {
+	s := &state{
 		errors:     make(ValidationErrors, 0),
+		seen:       make(map[errorKey]struct{}),
 		translator: NewTranslator(),
+		locale:     LocaleES,
+		rules:      newRuleRegistry(),
+		fieldName:  fieldName,
+		workers:    defaultAsyncWorkers,
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return &Validator{shared: s}
+}
+
+// Scope returns a validator whose field names are prefixed with the given
+// field, e.g. Scope("address") reports "street" as "address.street".
+// Errors, translator and context are shared with the parent validator
+func (v *Validator) Scope(field string) *Validator {
+	scoped := *v
+	scoped.path = joinPath(v.path, field)
+
+	return &scoped
+}
+
+// Index returns a validator for the element at position i, e.g. Index(3) on a
+// validator scoped to "items" reports "quantity" as "items[3].quantity"
+func (v *Validator) Index(i int) *Validator {
+	return v.Scope("[" + strconv.Itoa(i) + "]")
+}
+
+// Path returns the field path the validator is scoped to
+func (v *Validator) Path() string {
+	return v.path
 }
 
-// SetLocale sets the validator's locale
+// SetTranslator replaces the validator's translator, e.g. with one created from
+// custom catalogs
+func (v *Validator) SetTranslator(translator Translator) {
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
+
+	v.shared.translator = translator
+}
+
+// SetLocale sets the locale the validator's messages are rendered in
 func (v *Validator) SetLocale(locale Locale) {
-	v.translator.SetLocale(locale)
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
+
+	v.shared.locale = locale
+}
+
+// Locale returns the locale the validator's messages are rendered in
+func (v *Validator) Locale() Locale {
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
+
+	return v.shared.locale
 }
 
-// AddError adds a validation error
+// AddError adds a validation error, unless the field already has one with the
+// same message key and params or the validator already holds the maximum set with
+// WithMaxErrors. It is safe for concurrent use
 func (v *Validator) AddError(field string, key MessageKey, params MessageParams) {
-	message := v.translator.Translate(v.translator.GetLocale(), key, params)
+	if v.failed != nil {
+		v.failed.Store(true)
+	}
+
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
 
-	v.errors = append(v.errors, ValidationError{
-		Field:      field,
+	path := joinPath(v.path, field)
+	id := newErrorKey(path, key, params)
+	if _, ok := v.shared.seen[id]; ok {
+		return
+	}
+
+	if v.shared.maxErrors > 0 && len(v.shared.errors) >= v.shared.maxErrors {
+		return
+	}
+	v.shared.seen[id] = struct{}{}
+
+	translator, locale := v.shared.translator, v.shared.locale
+	message := translator.Translate(locale, key, withLabels(translator, v.shared.labels, locale, path, params))
+
+	v.shared.errors = append(v.shared.errors, ValidationError{
+		Field:      path,
 		Message:    message,
 		MessageKey: key,
+		Params:     params,
+		labels:     v.shared.labels,
 	})
 }
 
 func (v *Validator) HasErrors() bool {
-	return len(v.errors) > 0
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
+
+	return len(v.shared.errors) > 0
 }
 
+// Errors returns a copy of the errors added so far
 func (v *Validator) Errors() ValidationErrors {
-	return v.errors
+	v.shared.mu.Lock()
+	defer v.shared.mu.Unlock()
+
+	errs := make(ValidationErrors, len(v.shared.errors))
+	copy(errs, v.shared.errors)
+
+	return errs
+}
+
+// joinPath appends a field name or an index to a field path
+func joinPath(path, field string) string {
+	switch {
+	case path == "":
+		return field
+	case field == "":
+		return path
+	case strings.HasPrefix(field, "["):
+		return path + field
+	}
+
+	return path + "." + field
 }
@@ -0,0 +1,90 @@
+package valid
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestScope(t *testing.T) {
+	v := New()
+	address := v.Scope("address")
+	address.String("street", "", StringRules().Required().Build()...)
+	address.Scope("geo").String("city", "", StringRules().Required().Build()...)
+	v.Scope("items").Index(2).Int("quantity", 0, NumberRules[int64]().Min(1).Build()...)
+	v.Scope("").String("name", "", StringRules().Required().Build()...)
+
+	assertErrors(t, v,
+		"address.street:required",
+		"address.geo.city:required",
+		"items[2].quantity:min_value",
+		"name:required",
+	)
+
+	if got := address.Scope("geo").Path(); got != "address.geo" {
+		t.Errorf("Path() = %q, want %q", got, "address.geo")
+	}
+
+	if !address.HasErrors() || len(address.Errors()) != 4 {
+		t.Errorf("scoped validators do not share the errors of their parent: %v", address.Errors())
+	}
+}
+
+func TestSliceEachScope(t *testing.T) {
+	v := New()
+	v.StringSlice("tags", []string{"go", ""}).Each(func(v *Validator, i int, tag string) {
+		v.String("", tag, StringRules().Required().Build()...)
+	})
+
+	assertErrors(t, v, "tags[1]:required")
+}
+
+func TestValidatorConcurrency(t *testing.T) {
+	translator := NewTranslator()
+	v := New(WithTranslator(translator))
+
+	const goroutines = 16
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+
+			item := v.Scope("items").Index(g)
+			item.Label("name", map[Locale]string{LocaleEN: "Name"})
+			item.AddError("name", MsgRequired, nil)
+			item.Struct(&tagUser{})
+			item.enqueue("sku", func(context.Context) (MessageKey, MessageParams, bool, error) {
+				return MsgNotFound, nil, false, nil
+			})
+
+			if err := v.Wait(); err != nil {
+				t.Error(err)
+			}
+
+			_ = v.Errors().Localize(translator, LocaleEN)
+			_ = translator.Translate(LocaleFR, MsgMinLength, MessageParams{"min": g})
+			_ = v.HasErrors()
+		}(g)
+	}
+	wg.Wait()
+
+	if err := v.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every goroutine adds its own errors: the 7 of tagUser, whose name error
+	// is the same as the one added by hand, and sku
+	errs := v.Errors()
+	if len(errs) != goroutines*8 {
+		t.Errorf("got %d errors, want %d", len(errs), goroutines*8)
+	}
+
+	for g := 0; g < goroutines; g++ {
+		if !errs.Has(fmt.Sprintf("items[%d].sku", g)) {
+			t.Errorf("missing the async error of items[%d]", g)
+		}
+	}
+}